@@ -3,21 +3,21 @@ package shopify
 import (
 	"bufio"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"os"
-	"path/filepath"
+	"iter"
+	"log/slog"
 	"reflect"
 	"regexp"
+	"strings"
+	"sync"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
-	"github.com/r0busta/go-shopify-graphql/v9/rand"
-	"github.com/r0busta/go-shopify-graphql/v9/utils"
-	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -29,8 +29,15 @@ const (
 //go:generate mockgen -destination=./mock/bulk_service.go -package=mock . BulkOperationService
 type BulkOperationService interface {
 	BulkQuery(ctx context.Context, query string, v interface{}) error
+	BulkQueryEach(ctx context.Context, query string, v interface{}, fn func() error) error
+	BulkMutation(ctx context.Context, mutation string, variables iter.Seq[map[string]interface{}]) ([]BulkMutationResult, error)
+
+	StartBulkQuery(ctx context.Context, query string) (*BulkOperationHandle, error)
+	ResumeBulkQuery(ctx context.Context, handle BulkOperationHandle, v interface{}) error
 
 	PostBulkQuery(ctx context.Context, query string) (*string, error)
+	GetBulkOperation(ctx context.Context, id string) (*model.BulkOperation, error)
+	WaitForBulkOperation(ctx context.Context, id string, interval time.Duration) (*model.BulkOperation, error)
 	GetCurrentBulkQuery(ctx context.Context) (*model.BulkOperation, error)
 	GetCurrentBulkQueryResultURL(ctx context.Context) (*string, error)
 	WaitForCurrentBulkQuery(ctx context.Context, interval time.Duration) (*model.BulkOperation, error)
@@ -40,6 +47,12 @@ type BulkOperationService interface {
 
 type BulkOperationServiceOp struct {
 	client *Client
+
+	mu         sync.Mutex
+	slots      map[model.BulkOperationType]chan struct{}
+	subscribed bool
+	// subscribeMu serialises subscribing to the finish webhook.
+	subscribeMu sync.Mutex
 }
 
 var _ BulkOperationService = &BulkOperationServiceOp{}
@@ -59,6 +72,16 @@ func init() {
 }
 
 func (s *BulkOperationServiceOp) PostBulkQuery(ctx context.Context, query string) (*string, error) {
+	op, err := s.postBulkQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &op.ID, nil
+}
+
+// postBulkQuery posts the bulk query and returns the created operation.
+func (s *BulkOperationServiceOp) postBulkQuery(ctx context.Context, query string) (*model.BulkOperation, error) {
 	m := mutationBulkOperationRunQuery{}
 	vars := map[string]interface{}{
 		"query": null.StringFrom(query),
@@ -68,21 +91,79 @@ func (s *BulkOperationServiceOp) PostBulkQuery(ctx context.Context, query string
 	if err != nil {
 		return nil, fmt.Errorf("error posting bulk query: %w", err)
 	}
-	if len(m.BulkOperationRunQueryResult.UserErrors) > 0 {
-		errors, _ := json.MarshalIndent(m.BulkOperationRunQueryResult.UserErrors, "", "    ")
-		return nil, fmt.Errorf("error posting bulk query: %s", errors)
+	if err := newUserErrorsError(m.BulkOperationRunQueryResult.UserErrors); err != nil {
+		return nil, fmt.Errorf("error posting bulk query: %w", err)
+	}
+
+	if m.BulkOperationRunQueryResult.BulkOperation == nil {
+		return nil, fmt.Errorf("Posted operation is nil")
+	}
+
+	return m.BulkOperationRunQueryResult.BulkOperation, nil
+}
+
+// GetBulkOperation returns the bulk operation by its ID.
+func (s *BulkOperationServiceOp) GetBulkOperation(ctx context.Context, id string) (*model.BulkOperation, error) {
+	q := `query bulkOperation($id: ID!) {
+		node(id: $id){
+			... on BulkOperation {
+				id
+				type
+				status
+				errorCode
+				createdAt
+				completedAt
+				objectCount
+				rootObjectCount
+				fileSize
+				url
+				partialDataUrl
+				query
+			}
+		}
+	}`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var out struct {
+		*model.BulkOperation `json:"node"`
+	}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	if out.BulkOperation == nil {
+		return nil, fmt.Errorf("bulk operation %s: %w", id, ErrNotFound)
 	}
 
-	return &m.BulkOperationRunQueryResult.BulkOperation.ID, nil
+	return out.BulkOperation, nil
+}
+
+// WaitForBulkOperation polls the bulk operation by its ID until it's finished or the context is done.
+// A zero interval polls with the client poll policy.
+func (s *BulkOperationServiceOp) WaitForBulkOperation(ctx context.Context, id string, interval time.Duration) (*model.BulkOperation, error) {
+	return s.waitForBulkOperation(ctx, s.pollPolicy(interval), func(ctx context.Context) (*model.BulkOperation, error) {
+		return s.GetBulkOperation(ctx, id)
+	})
 }
 
 func (s *BulkOperationServiceOp) GetCurrentBulkQuery(ctx context.Context) (*model.BulkOperation, error) {
+	return s.getCurrentBulkOperation(ctx, model.BulkOperationTypeQuery)
+}
+
+func (s *BulkOperationServiceOp) getCurrentBulkOperation(ctx context.Context, operationType model.BulkOperationType) (*model.BulkOperation, error) {
 	var q struct {
 		CurrentBulkOperation struct {
 			model.BulkOperation
-		}
+		} `graphql:"currentBulkOperation(type: $type)"`
+	}
+	vars := map[string]interface{}{
+		"type": operationType,
 	}
-	err := s.client.gql.Query(ctx, &q, nil)
+	err := s.client.gql.Query(ctx, &q, vars)
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
@@ -94,21 +175,34 @@ func (s *BulkOperationServiceOp) GetCurrentBulkQueryResultURL(ctx context.Contex
 }
 
 func (s *BulkOperationServiceOp) ShouldGetBulkQueryResultURL(ctx context.Context, id *string) (*string, error) {
-	q, err := s.GetCurrentBulkQuery(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("error getting current bulk operation: %w", err)
-	}
+	return s.shouldGetBulkOperationResultURL(ctx, model.BulkOperationTypeQuery, id)
+}
 
-	if id != nil && q.ID != *id {
-		return nil, fmt.Errorf("Bulk operation ID doesn't match, got=%v, want=%v", q.ID, id)
+// shouldGetBulkOperationResultURL waits for the bulk operation with the ID, or the current one if the ID is nil,
+// and returns its result URL. The URL is nil if the operation has no results.
+func (s *BulkOperationServiceOp) shouldGetBulkOperationResultURL(ctx context.Context, operationType model.BulkOperationType, id *string) (*string, error) {
+	var q *model.BulkOperation
+	var err error
+	switch {
+	case id == nil:
+		q, err = s.waitForCurrentBulkOperation(ctx, operationType, s.client.bulkPollPolicy)
+	case s.finishWebhookEnabled():
+		q, err = s.waitForBulkOperationWebhook(ctx, *id)
+	default:
+		q, err = s.WaitForBulkOperation(ctx, *id, 0)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	q, _ = s.WaitForCurrentBulkQuery(ctx, 1*time.Second)
 	if q.Status != model.BulkOperationStatusCompleted {
 		return nil, fmt.Errorf("Bulk operation didn't complete, status=%s, error_code=%s", q.Status, q.ErrorCode)
 	}
 
 	if q.ErrorCode != nil && q.ErrorCode.String() != "" {
+		if *q.ErrorCode == model.BulkOperationErrorCodeAccessDenied {
+			return nil, fmt.Errorf("Bulk operation error: %w", ErrAccessDenied)
+		}
 		return nil, fmt.Errorf("Bulk operation error: %s", q.ErrorCode)
 	}
 
@@ -123,26 +217,59 @@ func (s *BulkOperationServiceOp) ShouldGetBulkQueryResultURL(ctx context.Context
 	return q.URL, nil
 }
 
+// WaitForCurrentBulkQuery polls the current bulk query until it's finished or the context is done.
+// A zero interval polls with the client poll policy.
 func (s *BulkOperationServiceOp) WaitForCurrentBulkQuery(ctx context.Context, interval time.Duration) (*model.BulkOperation, error) {
-	q, err := s.GetCurrentBulkQuery(ctx)
+	return s.waitForCurrentBulkOperation(ctx, model.BulkOperationTypeQuery, s.pollPolicy(interval))
+}
+
+func (s *BulkOperationServiceOp) waitForCurrentBulkOperation(ctx context.Context, operationType model.BulkOperationType, policy PollPolicy) (*model.BulkOperation, error) {
+	return s.waitForBulkOperation(ctx, policy, func(ctx context.Context) (*model.BulkOperation, error) {
+		q, err := s.getCurrentBulkOperation(ctx, operationType)
+		if err != nil {
+			return q, fmt.Errorf("CurrentBulkOperation query error: %w", err)
+		}
+		return q, nil
+	})
+}
+
+// waitForBulkOperation polls the bulk operation returned by get until it's finished or the context is done.
+func (s *BulkOperationServiceOp) waitForBulkOperation(ctx context.Context, policy PollPolicy, get func(ctx context.Context) (*model.BulkOperation, error)) (*model.BulkOperation, error) {
+	q, err := get(ctx)
 	if err != nil {
-		return q, fmt.Errorf("CurrentBulkOperation query error: %w", err)
+		return q, err
 	}
 
-	for q.Status == model.BulkOperationStatusCreated || q.Status == model.BulkOperationStatusRunning || q.Status == model.BulkOperationStatusCanceling {
-		log.Debugf("Bulk operation is still %s...", q.Status)
-		time.Sleep(interval)
+	interval := policy.initial()
+	for isBulkOperationInProgress(q) {
+		s.client.log(ctx, slog.LevelDebug, "Bulk operation is still in progress", "operation_id", q.ID, "status", q.Status, "next_check_in", interval)
+		if err := sleepContext(ctx, interval); err != nil {
+			return q, err
+		}
+		interval = policy.next(interval)
 
-		q, err = s.GetCurrentBulkQuery(ctx)
+		q, err = get(ctx)
 		if err != nil {
-			return q, fmt.Errorf("CurrentBulkOperation query error: %w", err)
+			return q, err
 		}
 	}
-	log.Debugf("Bulk operation ready, latest status=%s", q.Status)
+	s.client.log(ctx, slog.LevelDebug, "Bulk operation ready", "operation_id", q.ID, "status", q.Status)
 
 	return q, nil
 }
 
+func (s *BulkOperationServiceOp) pollPolicy(interval time.Duration) PollPolicy {
+	if interval <= 0 {
+		return s.client.bulkPollPolicy
+	}
+	return fixedPollPolicy(interval)
+}
+
+func isBulkOperationInProgress(q *model.BulkOperation) bool {
+	return q.Status == model.BulkOperationStatusCreated || q.Status == model.BulkOperationStatusRunning || q.Status == model.BulkOperationStatusCanceling
+}
+
+// CancelRunningBulkQuery cancels the current bulk query, if any, and waits until it's cancelled or the context is done.
 func (s *BulkOperationServiceOp) CancelRunningBulkQuery(ctx context.Context) error {
 	q, err := s.GetCurrentBulkQuery(ctx)
 	if err != nil {
@@ -150,72 +277,95 @@ func (s *BulkOperationServiceOp) CancelRunningBulkQuery(ctx context.Context) err
 	}
 
 	if q.Status == model.BulkOperationStatusCreated || q.Status == model.BulkOperationStatusRunning {
-		log.Debugln("Canceling running operation")
+		s.client.log(ctx, slog.LevelDebug, "Canceling running operation", "operation_id", q.ID)
 		operationID := q.ID
 
-		m := mutationBulkOperationRunQueryCancel{}
-		vars := map[string]interface{}{
-			"id": operationID,
-		}
-
-		err = s.client.gql.Mutate(ctx, &m, vars)
+		err = s.cancelBulkOperation(ctx, operationID)
 		if err != nil {
-			return fmt.Errorf("mutation: %w", err)
-		}
-		if len(m.BulkOperationCancelResult.UserErrors) > 0 {
-			return fmt.Errorf("%+v", m.BulkOperationCancelResult.UserErrors)
+			return err
 		}
 
-		q, err = s.GetCurrentBulkQuery(ctx)
+		_, err = s.WaitForBulkOperation(ctx, operationID, 0)
 		if err != nil {
-			return err
-		}
-		for q.Status == model.BulkOperationStatusCreated || q.Status == model.BulkOperationStatusRunning || q.Status == model.BulkOperationStatusCanceling {
-			log.Tracef("Bulk operation still %s...", q.Status)
-			q, err = s.GetCurrentBulkQuery(ctx)
-			if err != nil {
-				return fmt.Errorf("get current bulk query: %w", err)
-			}
+			return fmt.Errorf("wait for bulk operation: %w", err)
 		}
-		log.Debugln("Bulk operation cancelled")
+		s.client.log(ctx, slog.LevelDebug, "Bulk operation cancelled", "operation_id", operationID)
 	}
 
 	return nil
 }
 
-func (s *BulkOperationServiceOp) BulkQuery(ctx context.Context, query string, out interface{}) error {
-	_, err := s.WaitForCurrentBulkQuery(ctx, 1*time.Second)
+func (s *BulkOperationServiceOp) cancelBulkOperation(ctx context.Context, id string) error {
+	m := mutationBulkOperationRunQueryCancel{}
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	err := s.client.gql.Mutate(ctx, &m, vars)
 	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+	if err := newUserErrorsError(m.BulkOperationCancelResult.UserErrors); err != nil {
 		return err
 	}
 
-	id, err := s.PostBulkQuery(ctx, query)
-	if err != nil {
-		return fmt.Errorf("post bulk query: %w", err)
+	return nil
+}
+
+// cancelAbandonedBulkOperation cancels the bulk operation that is no longer waited for as the context is done.
+func (s *BulkOperationServiceOp) cancelAbandonedBulkOperation(ctx context.Context, id string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), bulkCancelTimeout)
+	defer cancel()
+
+	s.client.log(ctx, slog.LevelDebug, "Canceling abandoned operation", "operation_id", id)
+	if err := s.cancelBulkOperation(ctx, id); err != nil {
+		s.client.log(ctx, slog.LevelWarn, "Failed to cancel abandoned operation", "operation_id", id, "error", err)
 	}
+}
 
-	if id == nil {
-		return fmt.Errorf("Posted operation ID is nil")
+func (s *BulkOperationServiceOp) BulkQuery(ctx context.Context, query string, out interface{}) (err error) {
+	ctx, span := s.client.startSpan(ctx, "shopify.bulk_query")
+	defer func() { endSpan(span, err) }()
+
+	result, err := s.openBulkQueryResult(ctx, span, query)
+	if err != nil {
+		return err
 	}
+	defer result.Close()
 
-	url, err := s.ShouldGetBulkQueryResultURL(ctx, id)
+	err = s.client.tracePhase(ctx, "shopify.bulk_query.parse", func(ctx context.Context) error {
+		return readBulkQueryResult(result, out)
+	})
 	if err != nil {
-		return fmt.Errorf("get bulk query result URL: %w", err)
+		return fmt.Errorf("parse bulk query result: %w", err)
 	}
 
-	if url == nil || *url == "" {
-		return fmt.Errorf("Operation result URL is empty")
+	return nil
+}
+
+// BulkQueryEach runs the bulk query and calls fn for every top-level object as soon as it's read along with its nested connections,
+// so that the whole result is never held in memory. Every object is decoded into a fresh value out points to.
+func (s *BulkOperationServiceOp) BulkQueryEach(ctx context.Context, query string, out interface{}, fn func() error) (err error) {
+	outValue := reflect.ValueOf(out)
+	if outValue.Kind() != reflect.Ptr || outValue.IsNil() {
+		return fmt.Errorf("the out arg is not a pointer")
 	}
 
-	filename := fmt.Sprintf("%s%s", rand.String(10), ".jsonl")
-	resultFile := filepath.Join(os.TempDir(), filename)
-	defer os.Remove(resultFile) // Avoid storage overflow in high traffic environments
-	err = utils.DownloadFile(resultFile, *url)
+	ctx, span := s.client.startSpan(ctx, "shopify.bulk_query")
+	defer func() { endSpan(span, err) }()
+
+	result, err := s.openBulkQueryResult(ctx, span, query)
 	if err != nil {
-		return fmt.Errorf("download file: %w", err)
+		return err
 	}
+	defer result.Close()
 
-	err = parseBulkQueryResult(resultFile, out)
+	err = s.client.tracePhase(ctx, "shopify.bulk_query.parse", func(ctx context.Context) error {
+		return streamBulkQueryResult(result, outValue.Elem().Type(), func(item reflect.Value) error {
+			outValue.Elem().Set(item)
+			return fn()
+		})
+	})
 	if err != nil {
 		return fmt.Errorf("parse bulk query result: %w", err)
 	}
@@ -223,7 +373,203 @@ func (s *BulkOperationServiceOp) BulkQuery(ctx context.Context, query string, ou
 	return nil
 }
 
-func parseBulkQueryResult(resultFilePath string, out interface{}) error {
+var errStopIteration = errors.New("stop iteration")
+
+// BulkQueryStream runs the bulk query and yields the top-level objects as soon as they are read along with their nested connections.
+// An error ends the sequence.
+func BulkQueryStream[T any](ctx context.Context, s BulkOperationService, query string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var item T
+		err := s.BulkQueryEach(ctx, query, &item, func() error {
+			if !yield(item, nil) {
+				return errStopIteration
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errStopIteration) {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// openBulkQueryResult runs the bulk query, waits for it to complete and opens its result.
+func (s *BulkOperationServiceOp) openBulkQueryResult(ctx context.Context, span trace.Span, query string) (io.ReadCloser, error) {
+	url, err := s.runBulkOperation(ctx, span, model.BulkOperationTypeQuery, s.bulkQueryPoster(query))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.openBulkQueryResultURL(ctx, url)
+}
+
+func (s *BulkOperationServiceOp) openBulkQueryResultURL(ctx context.Context, url *string) (io.ReadCloser, error) {
+	if url == nil || *url == "" {
+		return nil, fmt.Errorf("Operation result URL is empty")
+	}
+
+	return s.openBulkOperationResult(ctx, "shopify.bulk_query", *url)
+}
+
+// bulkQueryPoster returns the function posting the bulk query and returning the operation ID.
+func (s *BulkOperationServiceOp) bulkQueryPoster(query string) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		id, err := s.PostBulkQuery(ctx, query)
+		if err != nil {
+			return "", err
+		}
+		if id == nil {
+			return "", fmt.Errorf("Posted operation ID is nil")
+		}
+		return *id, nil
+	}
+}
+
+const (
+	// concurrentBulkOperationsVersion is the first API version running several bulk operations of the same type per shop,
+	// up to maxConcurrentBulkOperations, according to the Shopify API release notes. Before it, a shop runs a single
+	// bulk query and a single bulk mutation at a time. Use WithBulkConcurrency if the limit of the shop differs.
+	concurrentBulkOperationsVersion = "2026-01"
+	maxConcurrentBulkOperations     = 5
+
+	bulkCancelTimeout = 10 * time.Second
+)
+
+// WithBulkConcurrency sets how many bulk operations of each type the client runs at once, overriding the limit
+// derived from the API version: 1 before 2026-01 and 5 since. With more than one, the client doesn't wait for
+// the bulk operation started elsewhere before posting its own.
+func WithBulkConcurrency(n int) Option {
+	return func(c *Client) {
+		c.bulkConcurrency = n
+	}
+}
+
+// concurrency returns how many bulk operations of each type the client runs at once.
+func (s *BulkOperationServiceOp) concurrency() int {
+	if s.client.bulkConcurrency > 0 {
+		return s.client.bulkConcurrency
+	}
+	if supportsConcurrentBulkOperations(s.client.apiVersion) {
+		return maxConcurrentBulkOperations
+	}
+	return 1
+}
+
+// runBulkOperation posts the bulk operation as soon as the shop can run it, waits for it to finish and returns its result URL.
+// Up to WithBulkConcurrency operations of each type run at once. Before the API versions running several bulk operations
+// of the same type, the operations of the client are serialised and the one started elsewhere is waited for.
+func (s *BulkOperationServiceOp) runBulkOperation(ctx context.Context, span trace.Span, operationType model.BulkOperationType, post func(ctx context.Context) (string, error)) (*string, error) {
+	release, err := s.acquireSlot(ctx, operationType)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	id, err := s.postBulkOperation(ctx, span, operationType, post)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.waitForBulkOperationResultURL(ctx, operationType, id)
+}
+
+// postBulkOperation posts the bulk operation once the one started elsewhere is finished on the API versions
+// running a single bulk operation of the type.
+func (s *BulkOperationServiceOp) postBulkOperation(ctx context.Context, span trace.Span, operationType model.BulkOperationType, post func(ctx context.Context) (string, error)) (string, error) {
+	if s.concurrency() == 1 && !supportsConcurrentBulkOperations(s.client.apiVersion) {
+		_, err := s.waitForCurrentBulkOperation(ctx, operationType, s.client.bulkPollPolicy)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err := s.ensureFinishWebhookSubscription(ctx); err != nil {
+		s.client.log(ctx, slog.LevelWarn, "Failed to subscribe to the bulk operations finish webhook, polling", "error", err)
+	}
+
+	var id string
+	err := s.client.tracePhase(ctx, bulkSpanPrefix(operationType)+".post", func(ctx context.Context) error {
+		var err error
+		id, err = post(ctx)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("post bulk operation: %w", err)
+	}
+	span.SetAttributes(attribute.String("shopify.bulk_operation.id", id))
+	s.client.log(ctx, slog.LevelDebug, "Bulk operation posted", "operation_id", id)
+
+	return id, nil
+}
+
+// waitForBulkOperationResultURL waits for the bulk operation to finish and returns its result URL,
+// cancelling the operation if the context is done and the client is configured to.
+func (s *BulkOperationServiceOp) waitForBulkOperationResultURL(ctx context.Context, operationType model.BulkOperationType, id string) (*string, error) {
+	var url *string
+	err := s.client.tracePhase(ctx, bulkSpanPrefix(operationType)+".poll", func(ctx context.Context) error {
+		var err error
+		url, err = s.shouldGetBulkOperationResultURL(ctx, operationType, &id)
+		return err
+	})
+	if err != nil {
+		if ctx.Err() != nil && s.client.bulkCancelOnContextDone {
+			s.cancelAbandonedBulkOperation(ctx, id)
+		}
+		return nil, fmt.Errorf("get bulk operation result URL: %w", err)
+	}
+
+	return url, nil
+}
+
+func bulkSpanPrefix(operationType model.BulkOperationType) string {
+	return "shopify.bulk_" + strings.ToLower(string(operationType))
+}
+
+// acquireSlot blocks until the client can run another bulk operation of the type and returns the function releasing the slot.
+func (s *BulkOperationServiceOp) acquireSlot(ctx context.Context, operationType model.BulkOperationType) (func(), error) {
+	s.mu.Lock()
+	if s.slots == nil {
+		s.slots = make(map[model.BulkOperationType]chan struct{})
+	}
+	slots, ok := s.slots[operationType]
+	if !ok {
+		slots = make(chan struct{}, s.concurrency())
+		s.slots[operationType] = slots
+	}
+	s.mu.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func supportsConcurrentBulkOperations(apiVersion string) bool {
+	return apiVersion == "unstable" || apiVersionAtLeast(apiVersion, concurrentBulkOperationsVersion)
+}
+
+// apiVersionAtLeast reports whether the dated API version, e.g. 2025-01, is the same as or later than the minimum one.
+func apiVersionAtLeast(apiVersion string, minVersion string) bool {
+	year, month, ok := parseAPIVersion(apiVersion)
+	if !ok {
+		return false
+	}
+	minYear, minMonth, _ := parseAPIVersion(minVersion)
+
+	return year > minYear || (year == minYear && month >= minMonth)
+}
+
+func parseAPIVersion(apiVersion string) (year int, month int, ok bool) {
+	t, err := time.Parse("2006-01", apiVersion)
+	if err != nil {
+		return 0, 0, false
+	}
+	return t.Year(), int(t.Month()), true
+}
+
+func readBulkQueryResult(r io.Reader, out interface{}) error {
 	if reflect.TypeOf(out).Kind() != reflect.Ptr {
 		return fmt.Errorf("the out arg is not a pointer")
 	}
@@ -234,129 +580,186 @@ func parseBulkQueryResult(resultFilePath string, out interface{}) error {
 		return fmt.Errorf("the out arg is not a pointer to a slice interface")
 	}
 
-	sliceItemType := outSlice.Type().Elem() // slice item type
-	sliceItemKind := sliceItemType.Kind()
-	itemType := sliceItemType // slice item underlying type
-	if sliceItemKind == reflect.Ptr {
-		itemType = itemType.Elem()
-	}
+	return streamBulkQueryResult(r, outSlice.Type().Elem(), func(item reflect.Value) error {
+		outSlice.Set(reflect.Append(outSlice, item))
+		return nil
+	})
+}
 
-	resultPath, err := os.Open(resultFilePath)
-	if err != nil {
-		return fmt.Errorf("open file: %w", err)
+// streamBulkQueryResult reads the JSONL result and calls fn with every top-level object of the item type
+// as soon as all its nested connections are read. It relies on the nested objects following their parent in the result.
+func streamBulkQueryResult(r io.Reader, itemType reflect.Type, fn func(item reflect.Value) error) error {
+	itemKind := itemType.Kind()
+	underlyingType := itemType
+	if itemKind == reflect.Ptr {
+		underlyingType = underlyingType.Elem()
 	}
-	defer utils.CloseFile(resultPath)
 
-	reader := bufio.NewReader(resultPath)
+	reader := bufio.NewReader(r)
 	json := jsoniter.ConfigFastest
 
+	var current reflect.Value
 	connectionSink := make(map[string]interface{})
+	parentTypes := make(map[string]reflect.Type)
+
+	flush := func() error {
+		if !current.IsValid() {
+			return nil
+		}
+
+		if len(connectionSink) > 0 {
+			err := attachNestedConnections(connectionSink, current)
+			if err != nil {
+				return fmt.Errorf("error processing nested connections: %w", err)
+			}
+			connectionSink = make(map[string]interface{})
+		}
+		clear(parentTypes)
+
+		item := current.Index(0)
+		current = reflect.Value{}
+
+		return fn(item)
+	}
 
 	for {
-		var line []byte
-		line, err = reader.ReadBytes('\n')
-		if err != nil {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("reading the result file: %w", err)
+		}
+		if len(line) == 0 {
 			break
 		}
 
 		parentIDNode := json.Get(line, "__parentId")
 		if parentIDNode.LastError() == nil {
-			parentID := parentIDNode.ToString()
-
-			gid := json.Get(line, "id")
-			if gid.LastError() != nil {
-				return fmt.Errorf("The connection type must query the `id` field")
+			err := sinkConnectionNode(connectionSink, parentTypes, parentIDNode.ToString(), line)
+			if err != nil {
+				return err
 			}
-			edgeType, nodeType, connectionFieldName, err := concludeObjectType(gid.ToString())
+		} else {
+			err := flush()
 			if err != nil {
 				return err
 			}
-			node := reflect.New(nodeType).Interface()
-			err = json.Unmarshal(line, &node)
+
+			item := reflect.New(underlyingType).Interface()
+			err = json.Unmarshal(line, &item)
 			if err != nil {
 				return fmt.Errorf("unmarshalling: %w", err)
 			}
-			nodeVal := reflect.ValueOf(node).Elem()
-
-			var edge interface{}
-			var edgeVal reflect.Value
-			var nodeField reflect.Value
-			if edgeType.Kind() == reflect.Ptr {
-				edge = reflect.New(edgeType.Elem()).Interface()
-				nodeField = reflect.ValueOf(edge).Elem().FieldByName(nodeFieldName)
-				edgeVal = reflect.ValueOf(edge)
-			} else {
-				edge = reflect.New(edgeType).Interface()
-
-				if reflect.ValueOf(edge).Kind() == reflect.Ptr {
-					nodeField = reflect.ValueOf(edge).Elem().FieldByName(nodeFieldName)
-				} else {
-					nodeField = reflect.ValueOf(edge).FieldByName(nodeFieldName)
-				}
-
-				edgeVal = reflect.ValueOf(edge).Elem()
+			itemVal := reflect.ValueOf(item)
+			if itemKind != reflect.Ptr {
+				itemVal = itemVal.Elem()
 			}
 
-			if !nodeField.IsValid() {
-				return fmt.Errorf("Edge in the '%s' doesn't have the Node field", connectionFieldName)
-			}
-			nodeField.Set(nodeVal)
-
-			var edgesSlice reflect.Value
-			var edges map[string]interface{}
-			if val, ok := connectionSink[parentID]; ok {
-				var ok2 bool
-				if edges, ok2 = val.(map[string]interface{}); !ok2 {
-					return fmt.Errorf("The connection sink for parent ID '%s' is not a map", parentID)
-				}
-			} else {
-				edges = make(map[string]interface{})
-			}
+			current = reflect.MakeSlice(reflect.SliceOf(itemType), 0, 1)
+			current = reflect.Append(current, itemVal)
 
-			if val, ok := edges[connectionFieldName]; ok {
-				edgesSlice = reflect.ValueOf(val)
-			} else {
-				edgesSliceCap := 50
-				edgesSlice = reflect.MakeSlice(reflect.SliceOf(edgeType), 0, edgesSliceCap)
+			if id := json.Get(line, "id"); id.LastError() == nil {
+				parentTypes[id.ToString()] = objectType(itemVal)
 			}
-
-			edgesSlice = reflect.Append(edgesSlice, edgeVal)
-
-			edges[connectionFieldName] = edgesSlice.Interface()
-			connectionSink[parentID] = edges
-
-			continue
 		}
 
-		item := reflect.New(itemType).Interface()
-		err = json.Unmarshal(line, &item)
-		if err != nil {
-			return fmt.Errorf("unmarshalling: %w", err)
+		if errors.Is(err, io.EOF) {
+			break
 		}
-		itemVal := reflect.ValueOf(item)
+	}
+
+	return flush()
+}
 
-		if sliceItemKind == reflect.Ptr {
-			outSlice.Set(reflect.Append(outSlice, itemVal))
+// sinkConnectionNode stores the nested connection node as an edge of its parent in the connection sink.
+// The types of the parents read so far are used to infer the connection and are updated with the type of the node.
+func sinkConnectionNode(connectionSink map[string]interface{}, parentTypes map[string]reflect.Type, parentID string, line []byte) error {
+	json := jsoniter.ConfigFastest
+
+	gid := json.Get(line, "id")
+	if gid.LastError() != nil {
+		return fmt.Errorf("The connection type must query the `id` field")
+	}
+	ct, err := resolveConnectionType(parentTypes[parentID], gid.ToString())
+	if err != nil {
+		return err
+	}
+	edgeType, nodeType, connectionFieldName := ct.EdgeType, ct.NodeType, ct.FieldName
+	node := reflect.New(nodeType).Interface()
+	err = json.Unmarshal(line, &node)
+	if err != nil {
+		return fmt.Errorf("unmarshalling: %w", err)
+	}
+	nodeVal := reflect.ValueOf(node).Elem()
+	parentTypes[gid.ToString()] = objectType(nodeVal)
+
+	var edge interface{}
+	var edgeVal reflect.Value
+	var nodeField reflect.Value
+	if edgeType.Kind() == reflect.Ptr {
+		edge = reflect.New(edgeType.Elem()).Interface()
+		nodeField = reflect.ValueOf(edge).Elem().FieldByName(nodeFieldName)
+		edgeVal = reflect.ValueOf(edge)
+	} else {
+		edge = reflect.New(edgeType).Interface()
+
+		if reflect.ValueOf(edge).Kind() == reflect.Ptr {
+			nodeField = reflect.ValueOf(edge).Elem().FieldByName(nodeFieldName)
 		} else {
-			outSlice.Set(reflect.Append(outSlice, itemVal.Elem()))
+			nodeField = reflect.ValueOf(edge).FieldByName(nodeFieldName)
 		}
+
+		edgeVal = reflect.ValueOf(edge).Elem()
 	}
 
-	if len(connectionSink) > 0 {
-		err := attachNestedConnections(connectionSink, outSlice)
-		if err != nil {
-			return fmt.Errorf("error processing nested connections: %w", err)
+	if !nodeField.IsValid() {
+		return fmt.Errorf("Edge in the '%s' doesn't have the Node field", connectionFieldName)
+	}
+	nodeField.Set(nodeVal)
+
+	var edgesSlice reflect.Value
+	var edges map[string]interface{}
+	if val, ok := connectionSink[parentID]; ok {
+		var ok2 bool
+		if edges, ok2 = val.(map[string]interface{}); !ok2 {
+			return fmt.Errorf("The connection sink for parent ID '%s' is not a map", parentID)
 		}
+	} else {
+		edges = make(map[string]interface{})
 	}
 
-	// check if ReadBytes returned an error different from EOF
-	if err != nil && !errors.Is(err, io.EOF) {
-		return fmt.Errorf("reading the result file: %w", err)
+	if val, ok := edges[connectionFieldName]; ok {
+		edgesSlice = reflect.ValueOf(val)
+	} else {
+		edgesSliceCap := 50
+		edgesSlice = reflect.MakeSlice(reflect.SliceOf(edgeType), 0, edgesSliceCap)
 	}
 
+	edgesSlice = reflect.Append(edgesSlice, edgeVal)
+
+	edges[connectionFieldName] = edgesSlice.Interface()
+	connectionSink[parentID] = edges
+
 	return nil
 }
 
+// objectType returns the struct type of the object, unwrapping the pointers and the edges.
+func objectType(v reflect.Value) reflect.Type {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	if node := v.FieldByName(nodeFieldName); node.IsValid() {
+		return objectType(node)
+	}
+
+	return v.Type()
+}
+
 func attachNestedConnections(connectionSink map[string]interface{}, outSlice reflect.Value) error {
 	for i := 0; i < outSlice.Len(); i++ {
 		parent := outSlice.Index(i)
@@ -377,6 +780,9 @@ func attachNestedConnections(connectionSink map[string]interface{}, outSlice ref
 
 		parentIDField := parent.FieldByName("ID")
 		if parentIDField == (reflect.Value{}) {
+			if !hasConnectionFields(parent.Type()) {
+				continue
+			}
 			return fmt.Errorf("No ID field on the first level")
 		}
 		if reflect.TypeOf(parentIDField).Kind() == reflect.Ptr {
@@ -409,8 +815,8 @@ func attachNestedConnections(connectionSink map[string]interface{}, outSlice ref
 				connectionValue = reflect.ValueOf(reflect.New(connectionField.Type().Elem()).Interface())
 				edgesField = connectionValue.Elem().FieldByName(edgesFieldName)
 			} else {
-				connectionValue = reflect.ValueOf(reflect.New(connectionField.Type()).Interface())
-				edgesField = connectionValue.Elem().FieldByName(edgesFieldName)
+				connectionValue = reflect.New(connectionField.Type()).Elem()
+				edgesField = connectionValue.FieldByName(edgesFieldName)
 			}
 
 			if !edgesField.IsValid() {
@@ -432,42 +838,12 @@ func attachNestedConnections(connectionSink map[string]interface{}, outSlice ref
 	return nil
 }
 
-func concludeObjectType(gid string) (reflect.Type, reflect.Type, string, error) {
-	submatches := gidRegex.FindStringSubmatch(gid)
-	if len(submatches) != 2 {
-		return reflect.TypeOf(nil), reflect.TypeOf(nil), "", fmt.Errorf("malformed gid=`%s`", gid)
-	}
-	resource := submatches[1]
-	switch resource {
-	case "LineItem":
-		return reflect.TypeOf(model.LineItemEdge{}), reflect.TypeOf(&model.LineItem{}), fmt.Sprintf("%ss", resource), nil
-	case "FulfillmentOrderLineItem":
-		return reflect.TypeOf(model.FulfillmentOrderLineItemEdge{}), reflect.TypeOf(&model.FulfillmentOrderLineItem{}), "LineItems", nil
-	case "FulfillmentOrder":
-		return reflect.TypeOf(model.FulfillmentOrderEdge{}), reflect.TypeOf(&model.FulfillmentOrder{}), fmt.Sprintf("%ss", resource), nil
-	case "MediaImage":
-		return reflect.TypeOf(model.MediaEdge{}), reflect.TypeOf(&model.MediaImage{}), "Media", nil
-	case "Video":
-		return reflect.TypeOf(model.MediaEdge{}), reflect.TypeOf(&model.Video{}), "Media", nil
-	case "Model3d":
-		return reflect.TypeOf(model.MediaEdge{}), reflect.TypeOf(&model.Model3d{}), "Media", nil
-	case "ExternalVideo":
-		return reflect.TypeOf(model.MediaEdge{}), reflect.TypeOf(&model.ExternalVideo{}), "Media", nil
-	case "Metafield":
-		return reflect.TypeOf(model.MetafieldEdge{}), reflect.TypeOf(&model.Metafield{}), fmt.Sprintf("%ss", resource), nil
-	case "Order":
-		return reflect.TypeOf(model.OrderEdge{}), reflect.TypeOf(&model.Order{}), fmt.Sprintf("%ss", resource), nil
-	case "Product":
-		return reflect.TypeOf(model.ProductEdge{}), reflect.TypeOf(&model.Product{}), fmt.Sprintf("%ss", resource), nil
-	case "ProductVariant":
-		return reflect.TypeOf(model.ProductVariantEdge{}), reflect.TypeOf(&model.ProductVariant{}), "Variants", nil
-	case "ProductImage":
-		return reflect.TypeOf(model.ImageEdge{}), reflect.TypeOf(&model.Image{}), "Images", nil
-	case "Collection":
-		return reflect.TypeOf(model.CollectionEdge{}), reflect.TypeOf(&model.Collection{}), "Collections", nil
-	case "InventoryLevel":
-		return reflect.TypeOf(model.InventoryLevelEdge{}), reflect.TypeOf(&model.InventoryLevel{}), fmt.Sprintf("%ss", resource), nil
-	default:
-		return reflect.TypeOf(nil), reflect.TypeOf(nil), "", fmt.Errorf("`%s` not implemented type", resource)
+// hasConnectionFields reports whether the struct type has nested connection fields.
+func hasConnectionFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if _, _, ok := connectionFieldTypes(t.Field(i).Type); ok {
+			return true
+		}
 	}
+	return false
 }
@@ -0,0 +1,82 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/r0busta/go-shopify-graphql/v9/rand"
+	"github.com/r0busta/go-shopify-graphql/v9/utils"
+)
+
+// WithBulkDownloader sets the downloader of the bulk operation results, e.g. to limit their size or tune the retries.
+// If the downloader has no HTTP client, the one set with WithHTTPClient and WithTransport is used.
+func WithBulkDownloader(d *utils.Downloader) Option {
+	return func(c *Client) {
+		if d == nil {
+			d = &utils.Downloader{}
+		}
+		c.bulkDownloader = d
+	}
+}
+
+// WithBulkStreamingDownload makes the client parse the bulk operation results while they are downloaded
+// instead of downloading them into a temporary file first.
+func WithBulkStreamingDownload() Option {
+	return func(c *Client) {
+		c.bulkStreamingDownload = true
+	}
+}
+
+// openBulkOperationResult opens the result of the bulk operation at the URL, streaming it from the network
+// or from the temporary file it's downloaded into.
+func (s *BulkOperationServiceOp) openBulkOperationResult(ctx context.Context, spanPrefix string, url string) (io.ReadCloser, error) {
+	var result io.ReadCloser
+	err := s.client.tracePhase(ctx, spanPrefix+".download", func(ctx context.Context) error {
+		var err error
+		if s.client.bulkStreamingDownload {
+			result, err = s.client.bulkDownloader.Open(ctx, url)
+			return err
+		}
+
+		result, err = downloadTempFile(ctx, s.client.bulkDownloader, url)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("download file: %w", err)
+	}
+
+	return result, nil
+}
+
+// tempFile is removed once closed.
+type tempFile struct {
+	*os.File
+}
+
+func (f *tempFile) Close() error {
+	err := f.File.Close()
+	os.Remove(f.Name()) // Avoid storage overflow in high traffic environments
+	return err
+}
+
+func downloadTempFile(ctx context.Context, d *utils.Downloader, url string) (io.ReadCloser, error) {
+	filename := fmt.Sprintf("%s%s", rand.String(10), ".jsonl")
+	resultFile := filepath.Join(os.TempDir(), filename)
+
+	err := d.DownloadFile(ctx, resultFile, url)
+	if err != nil {
+		os.Remove(resultFile)
+		return nil, err
+	}
+
+	f, err := os.Open(resultFile)
+	if err != nil {
+		os.Remove(resultFile)
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+
+	return &tempFile{File: f}, nil
+}
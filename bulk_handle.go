@@ -0,0 +1,87 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// BulkOperationHandle identifies a started bulk query. It can be stored, e.g. as JSON, to resume waiting for the query
+// and reading its result with ResumeBulkQuery after the process restarts.
+type BulkOperationHandle struct {
+	ID    string `json:"id"`
+	Query string `json:"query"`
+	// CreatedAt is when Shopify created the operation, or zero if it's not reported.
+	CreatedAt time.Time `json:"createdAt"`
+	Shop      string    `json:"shop,omitempty"`
+}
+
+// StartBulkQuery posts the bulk query and returns its handle without waiting for it to finish.
+func (s *BulkOperationServiceOp) StartBulkQuery(ctx context.Context, query string) (handle *BulkOperationHandle, err error) {
+	ctx, span := s.client.startSpan(ctx, "shopify.bulk_query.start")
+	defer func() { endSpan(span, err) }()
+
+	release, err := s.acquireSlot(ctx, model.BulkOperationTypeQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var op *model.BulkOperation
+	_, err = s.postBulkOperation(ctx, span, model.BulkOperationTypeQuery, func(ctx context.Context) (string, error) {
+		var err error
+		op, err = s.postBulkQuery(ctx, query)
+		if err != nil {
+			return "", err
+		}
+		return op.ID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	handle = &BulkOperationHandle{
+		ID:    op.ID,
+		Query: query,
+		Shop:  s.client.shopName,
+	}
+	if createdAt, err := time.Parse(time.RFC3339, op.CreatedAt); err == nil {
+		handle.CreatedAt = createdAt.UTC()
+	}
+
+	return handle, nil
+}
+
+// ResumeBulkQuery waits for the bulk query started with StartBulkQuery to finish and reads its result into v,
+// a pointer to a slice like in BulkQuery.
+func (s *BulkOperationServiceOp) ResumeBulkQuery(ctx context.Context, handle BulkOperationHandle, v interface{}) (err error) {
+	ctx, span := s.client.startSpan(ctx, "shopify.bulk_query", attribute.String("shopify.bulk_operation.id", handle.ID))
+	defer func() { endSpan(span, err) }()
+
+	if handle.Shop != "" && s.client.shopName != "" && handle.Shop != s.client.shopName {
+		return fmt.Errorf("bulk operation %s was started for the shop %s", handle.ID, handle.Shop)
+	}
+
+	url, err := s.waitForBulkOperationResultURL(ctx, model.BulkOperationTypeQuery, handle.ID)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.openBulkQueryResultURL(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer result.Close()
+
+	err = s.client.tracePhase(ctx, "shopify.bulk_query.parse", func(ctx context.Context) error {
+		return readBulkQueryResult(result, v)
+	})
+	if err != nil {
+		return fmt.Errorf("parse bulk query result: %w", err)
+	}
+
+	return nil
+}
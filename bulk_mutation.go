@@ -0,0 +1,299 @@
+package shopify
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"mime/multipart"
+	"net/http"
+	"sort"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
+	"github.com/r0busta/graphql"
+)
+
+// ErrNoBulkMutationVariables is returned when the bulk mutation variables sequence is empty.
+var ErrNoBulkMutationVariables = errors.New("no bulk mutation variables")
+
+const (
+	bulkMutationVariablesFilename = "bulk_op_vars.jsonl"
+	bulkMutationVariablesMimeType = "text/jsonl"
+	stagedUploadPathParameterName = "key"
+)
+
+type mutationStagedUploadsCreate struct {
+	StagedUploadsCreateResult struct {
+		StagedTargets []model.StagedMediaUploadTarget `json:"stagedTargets,omitempty"`
+		UserErrors    []model.UserError               `json:"userErrors,omitempty"`
+	} `graphql:"stagedUploadsCreate(input: $input)" json:"stagedUploadsCreate"`
+}
+
+type mutationBulkOperationRunMutation struct {
+	BulkOperationRunMutationResult struct {
+		BulkOperation *struct {
+			ID string `json:"id"`
+		} `json:"bulkOperation,omitempty"`
+		UserErrors []model.BulkMutationUserError `json:"userErrors,omitempty"`
+	} `graphql:"bulkOperationRunMutation(mutation: $mutation, stagedUploadPath: $stagedUploadPath)" json:"bulkOperationRunMutation"`
+}
+
+// BulkMutationResult is the result of the mutation run with a single line of the bulk mutation variables.
+type BulkMutationResult struct {
+	// Line is the zero-based number of the variables line the result belongs to.
+	Line int
+	// Data is the raw mutation response data.
+	Data json.RawMessage
+
+	Errors     []graphqlclient.Error
+	UserErrors []UserError
+}
+
+// Err returns the GraphQL errors or the user errors of the line, or nil if the mutation succeeded.
+func (r *BulkMutationResult) Err() error {
+	if len(r.Errors) > 0 {
+		return wrapGraphQLError(errors.New(r.Errors[0].Message), &graphqlclient.ResponseInfo{Errors: r.Errors})
+	}
+	if len(r.UserErrors) > 0 {
+		return &UserErrorsError{Errors: r.UserErrors}
+	}
+	return nil
+}
+
+// Decode unmarshals the mutation response data into v.
+func (r *BulkMutationResult) Decode(v interface{}) error {
+	return json.Unmarshal(r.Data, v)
+}
+
+// BulkMutation runs the mutation once for every variables in the sequence as a bulk operation and returns the results in the order of the variables.
+// It returns ErrNoBulkMutationVariables if the sequence is empty.
+func (s *BulkOperationServiceOp) BulkMutation(ctx context.Context, mutation string, variables iter.Seq[map[string]interface{}]) (res []BulkMutationResult, err error) {
+	ctx, span := s.client.startSpan(ctx, "shopify.bulk_mutation")
+	defer func() { endSpan(span, err) }()
+
+	var stagedUploadPath string
+	err = s.client.tracePhase(ctx, "shopify.bulk_mutation.upload", func(ctx context.Context) error {
+		stagedUploadPath, err = s.uploadBulkMutationVariables(ctx, variables)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("upload variables: %w", err)
+	}
+
+	url, err := s.runBulkOperation(ctx, span, model.BulkOperationTypeMutation, func(ctx context.Context) (string, error) {
+		return s.postBulkMutation(ctx, mutation, stagedUploadPath)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if url == nil || *url == "" {
+		return []BulkMutationResult{}, nil
+	}
+
+	result, err := s.openBulkOperationResult(ctx, "shopify.bulk_mutation", *url)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	err = s.client.tracePhase(ctx, "shopify.bulk_mutation.parse", func(ctx context.Context) error {
+		res, err = readBulkMutationResult(result)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parse bulk mutation result: %w", err)
+	}
+
+	return res, nil
+}
+
+// uploadBulkMutationVariables uploads the variables as a JSONL file to the staged upload target and returns its path.
+// The file is encoded while it's uploaded, so that the variables are never held in memory.
+func (s *BulkOperationServiceOp) uploadBulkMutationVariables(ctx context.Context, variables iter.Seq[map[string]interface{}]) (string, error) {
+	next, stop := iter.Pull(variables)
+	streaming := false
+	defer func() {
+		if !streaming {
+			stop()
+		}
+	}()
+
+	first, ok := next()
+	if !ok {
+		return "", ErrNoBulkMutationVariables
+	}
+
+	m := mutationStagedUploadsCreate{}
+	httpMethod := model.StagedUploadHTTPMethodTypePost
+	vars := map[string]interface{}{
+		"input": []model.StagedUploadInput{{
+			Resource:   model.StagedUploadTargetGenerateUploadResourceBulkMutationVariables,
+			Filename:   bulkMutationVariablesFilename,
+			MimeType:   bulkMutationVariablesMimeType,
+			HTTPMethod: &httpMethod,
+		}},
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return "", fmt.Errorf("mutation: %w", err)
+	}
+	if err := newUserErrorsError(m.StagedUploadsCreateResult.UserErrors); err != nil {
+		return "", err
+	}
+	if len(m.StagedUploadsCreateResult.StagedTargets) == 0 || m.StagedUploadsCreateResult.StagedTargets[0].URL == nil {
+		return "", fmt.Errorf("no staged upload target")
+	}
+	target := m.StagedUploadsCreateResult.StagedTargets[0]
+
+	var path string
+	for _, p := range target.Parameters {
+		if p.Name == stagedUploadPathParameterName {
+			path = p.Value
+		}
+	}
+	if path == "" {
+		return "", fmt.Errorf("staged upload target has no `%s` parameter", stagedUploadPathParameterName)
+	}
+
+	pr, pw := io.Pipe()
+	w := multipart.NewWriter(pw)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, *target.URL, pr)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	streaming = true
+	go func() {
+		defer stop()
+		pw.CloseWithError(writeBulkMutationVariablesForm(w, target.Parameters, first, next))
+	}()
+
+	resp, err := s.client.externalHTTPClient().Do(req)
+	// Unblock the writer if the request failed before the whole body was read.
+	pr.Close()
+	if err != nil {
+		return "", fmt.Errorf("upload: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("upload: unexpected status %s: %s", resp.Status, respBody)
+	}
+
+	return path, nil
+}
+
+// writeBulkMutationVariablesForm writes the staged upload parameters followed by the variables JSONL file as the multipart form.
+func writeBulkMutationVariablesForm(w *multipart.Writer, params []model.StagedUploadParameter, first map[string]interface{}, next func() (map[string]interface{}, bool)) error {
+	for _, p := range params {
+		if err := w.WriteField(p.Name, p.Value); err != nil {
+			return err
+		}
+	}
+
+	fw, err := w.CreateFormFile("file", bulkMutationVariablesFilename)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(fw)
+	for vars, ok := first, true; ok; vars, ok = next() {
+		if err := enc.Encode(vars); err != nil {
+			return fmt.Errorf("encode variables: %w", err)
+		}
+	}
+
+	return w.Close()
+}
+
+func (s *BulkOperationServiceOp) postBulkMutation(ctx context.Context, mutation string, stagedUploadPath string) (string, error) {
+	m := mutationBulkOperationRunMutation{}
+	vars := map[string]interface{}{
+		"mutation":         graphql.String(mutation),
+		"stagedUploadPath": graphql.String(stagedUploadPath),
+	}
+
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return "", fmt.Errorf("mutation: %w", err)
+	}
+	if err := newUserErrorsError(m.BulkOperationRunMutationResult.UserErrors); err != nil {
+		return "", err
+	}
+	if m.BulkOperationRunMutationResult.BulkOperation == nil {
+		return "", fmt.Errorf("Posted operation is nil")
+	}
+
+	return m.BulkOperationRunMutationResult.BulkOperation.ID, nil
+}
+
+func readBulkMutationResult(r io.Reader) ([]BulkMutationResult, error) {
+	res := []BulkMutationResult{}
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("reading the result file: %w", err)
+		}
+		if len(bytes.TrimSpace(line)) > 0 {
+			var out struct {
+				Data       json.RawMessage       `json:"data"`
+				Errors     []graphqlclient.Error `json:"errors"`
+				LineNumber int                   `json:"__lineNumber"`
+			}
+			if err := json.Unmarshal(line, &out); err != nil {
+				return nil, fmt.Errorf("unmarshalling: %w", err)
+			}
+
+			item := BulkMutationResult{
+				Line:   out.LineNumber,
+				Data:   out.Data,
+				Errors: out.Errors,
+			}
+
+			var payloads map[string]json.RawMessage
+			_ = json.Unmarshal(out.Data, &payloads)
+			for _, payload := range payloads {
+				var p struct {
+					UserErrors []struct {
+						Field   []string `json:"field"`
+						Message string   `json:"message"`
+						Code    string   `json:"code"`
+					} `json:"userErrors"`
+				}
+				if err := json.Unmarshal(payload, &p); err != nil {
+					continue
+				}
+				for _, ue := range p.UserErrors {
+					item.UserErrors = append(item.UserErrors, UserError{
+						UserError: model.UserError{
+							Field:   ue.Field,
+							Message: ue.Message,
+						},
+						Code: ue.Code,
+					})
+				}
+			}
+
+			res = append(res, item)
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Line < res[j].Line
+	})
+
+	return res, nil
+}
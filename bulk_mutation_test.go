@@ -0,0 +1,139 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingTransport struct {
+	calls int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestBulkMutationUploadAndPost(t *testing.T) {
+	var uploaded string
+	var queries []string
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/upload" {
+			assert.Empty(t, r.Header.Get("X-Shopify-Access-Token"))
+			assert.Equal(t, int64(-1), r.ContentLength, "the variables are streamed")
+			assert.Equal(t, "tmp/bulk_op_vars.jsonl", r.FormValue("key"))
+			f, _, err := r.FormFile("file")
+			require.NoError(t, err)
+			b, _ := io.ReadAll(f)
+			uploaded = string(b)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var body struct {
+			Query string `json:"query"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		queries = append(queries, body.Query)
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(body.Query, "stagedUploadsCreate") {
+			fmt.Fprintf(w, `{"data":{"stagedUploadsCreate":{"stagedTargets":[{"url":"%s/upload","parameters":[{"name":"key","value":"tmp/bulk_op_vars.jsonl"}]}]}}}`, srv.URL)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"bulkOperationRunMutation":{"bulkOperation":{"id":"gid://shopify/BulkOperation/1"}}}}`))
+	}))
+	defer srv.Close()
+
+	rt := &countingTransport{}
+	c, err := NewClientWithTokenE("token", "store", WithBaseURL(srv.URL), WithTransport(rt), WithLogger(nil))
+	require.NoError(t, err)
+	s := &BulkOperationServiceOp{client: c}
+
+	vars := []map[string]interface{}{
+		{"input": map[string]interface{}{"id": "gid://shopify/Product/1"}},
+		{"input": map[string]interface{}{"id": "gid://shopify/Product/2"}},
+	}
+	path, err := s.uploadBulkMutationVariables(context.Background(), slices.Values(vars))
+	require.NoError(t, err)
+	assert.Equal(t, "tmp/bulk_op_vars.jsonl", path)
+	assert.Equal(t, `{"input":{"id":"gid://shopify/Product/1"}}`+"\n"+`{"input":{"id":"gid://shopify/Product/2"}}`+"\n", uploaded)
+
+	id, err := s.postBulkMutation(context.Background(), "mutation call($input: ProductInput!) { productUpdate(input: $input) { product { id } } }", path)
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/BulkOperation/1", id)
+
+	require.Len(t, queries, 2)
+	assert.Contains(t, queries[1], "$mutation:String!")
+	assert.Contains(t, queries[1], "$stagedUploadPath:String!")
+	assert.Equal(t, 3, rt.calls, "the upload should be sent through the configured transport")
+}
+
+func TestBulkMutationWithoutVariables(t *testing.T) {
+	gql := &fakeGraphQL{responses: []string{`{}`}}
+	c, err := NewClientE(WithGraphQLClient(gql), WithLogger(nil))
+	require.NoError(t, err)
+
+	_, err = c.BulkOperation.BulkMutation(context.Background(), "mutation call($input: ProductInput!) { productUpdate(input: $input) { product { id } } }", slices.Values([]map[string]interface{}{}))
+	assert.ErrorIs(t, err, ErrNoBulkMutationVariables)
+	assert.Nil(t, gql.variables, "nothing is staged")
+}
+
+func TestBulkMutationUploadRejected(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/upload" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":{"stagedUploadsCreate":{"stagedTargets":[{"url":"%s/upload","parameters":[{"name":"key","value":"tmp/bulk_op_vars.jsonl"}]}]}}}`, srv.URL)
+	}))
+	defer srv.Close()
+
+	c, err := NewClientWithTokenE("token", "store", WithBaseURL(srv.URL), WithLogger(nil))
+	require.NoError(t, err)
+	s := &BulkOperationServiceOp{client: c}
+
+	vars := func(yield func(map[string]interface{}) bool) {
+		for i := 0; i < 100000; i++ {
+			if !yield(map[string]interface{}{"input": map[string]interface{}{"id": fmt.Sprintf("gid://shopify/Product/%d", i)}}) {
+				return
+			}
+		}
+	}
+	_, err = s.uploadBulkMutationVariables(context.Background(), vars)
+	assert.ErrorContains(t, err, "403")
+}
+
+func TestBulkOperationResultDownloadedWithConfiguredTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"gid://shopify/Product/1"}` + "\n"))
+	}))
+	defer srv.Close()
+
+	rt := &countingTransport{}
+	c, err := NewClientE(WithGraphQLClient(&fakeGraphQL{}), WithTransport(rt), WithLogger(nil))
+	require.NoError(t, err)
+	s := &BulkOperationServiceOp{client: c}
+
+	result, err := s.openBulkOperationResult(context.Background(), "shopify.bulk_query", srv.URL)
+	require.NoError(t, err)
+	defer result.Close()
+
+	b, err := io.ReadAll(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"gid://shopify/Product/1"}`, string(b))
+	assert.Equal(t, 1, rt.calls)
+}
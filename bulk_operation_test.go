@@ -0,0 +1,238 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// fakeBulkGraphQL runs bulk queries that complete as soon as they're polled, tracking how many run at once.
+type fakeBulkGraphQL struct {
+	mu         sync.Mutex
+	posted     int
+	running    int
+	maxRunning int
+	cancelled  int
+
+	// status is the status of the polled operations, COMPLETED if empty.
+	status model.BulkOperationStatus
+	// url is the result URL of the completed operations, if any.
+	url string
+	// postBarrier, if set, holds the posts until it's closed.
+	postBarrier chan struct{}
+}
+
+func (f *fakeBulkGraphQL) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	return json.Unmarshal([]byte(`{"currentBulkOperation":{"status":"COMPLETED"}}`), q)
+}
+
+func (f *fakeBulkGraphQL) QueryString(ctx context.Context, q string, variables map[string]interface{}, v interface{}) error {
+	status := f.status
+	if status == "" {
+		status = model.BulkOperationStatusCompleted
+	}
+
+	f.mu.Lock()
+	if status == model.BulkOperationStatusCompleted {
+		f.running--
+	}
+	f.mu.Unlock()
+
+	if f.url != "" {
+		return json.Unmarshal([]byte(fmt.Sprintf(`{"node":{"id":%q,"status":%q,"objectCount":"1","url":%q}}`, variables["id"], status, f.url)), v)
+	}
+	return json.Unmarshal([]byte(fmt.Sprintf(`{"node":{"id":%q,"status":%q,"objectCount":"0"}}`, variables["id"], status)), v)
+}
+
+func (f *fakeBulkGraphQL) Mutate(ctx context.Context, m interface{}, variables map[string]interface{}) error {
+	if _, ok := m.(*mutationBulkOperationRunQueryCancel); ok {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		f.mu.Lock()
+		f.cancelled++
+		f.mu.Unlock()
+		return nil
+	}
+
+	f.mu.Lock()
+	f.posted++
+	f.running++
+	f.maxRunning = max(f.maxRunning, f.running)
+	id := fmt.Sprintf("gid://shopify/BulkOperation/%d", f.posted)
+	f.mu.Unlock()
+
+	if f.postBarrier != nil {
+		select {
+		case <-f.postBarrier:
+		case <-time.After(time.Second):
+		}
+	}
+
+	m.(*mutationBulkOperationRunQuery).BulkOperationRunQueryResult.BulkOperation = &model.BulkOperation{ID: id, CreatedAt: "2024-01-01T10:00:00Z"}
+	return nil
+}
+
+func (f *fakeBulkGraphQL) MutateString(ctx context.Context, m string, variables map[string]interface{}, v interface{}) error {
+	return fmt.Errorf("not implemented")
+}
+
+func postTestBulkQuery(s *BulkOperationServiceOp) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		id, err := s.PostBulkQuery(ctx, "{ products { edges { node { id } } } }")
+		if err != nil {
+			return "", err
+		}
+		return *id, nil
+	}
+}
+
+func runConcurrentBulkQueries(t *testing.T, apiVersion string, gql *fakeBulkGraphQL, n int, opts ...Option) {
+	c, err := NewClientE(append([]Option{WithGraphQLClient(gql), WithLogger(nil)}, opts...)...)
+	require.NoError(t, err)
+	c.apiVersion = apiVersion
+	s := c.BulkOperation.(*BulkOperationServiceOp)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			url, err := s.runBulkOperation(context.Background(), trace.SpanFromContext(context.Background()), model.BulkOperationTypeQuery, postTestBulkQuery(s))
+			assert.NoError(t, err)
+			assert.Nil(t, url)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRunBulkOperationSerialisedOnOlderVersions(t *testing.T) {
+	gql := &fakeBulkGraphQL{}
+	runConcurrentBulkQueries(t, "2025-01", gql, 3)
+
+	assert.Equal(t, 3, gql.posted)
+	assert.Equal(t, 1, gql.maxRunning)
+}
+
+// releasePostsOnceAllPosted holds the posts until n operations are posted, so that they all run at once.
+func releasePostsOnceAllPosted(gql *fakeBulkGraphQL, n int) {
+	gql.postBarrier = make(chan struct{})
+	go func() {
+		for {
+			gql.mu.Lock()
+			posted := gql.posted
+			gql.mu.Unlock()
+			if posted == n {
+				close(gql.postBarrier)
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+}
+
+func TestRunBulkOperationConcurrentOnNewerVersions(t *testing.T) {
+	gql := &fakeBulkGraphQL{}
+	releasePostsOnceAllPosted(gql, 3)
+	runConcurrentBulkQueries(t, "2026-01", gql, 3)
+
+	assert.Equal(t, 3, gql.maxRunning)
+}
+
+func TestRunBulkOperationWithBulkConcurrency(t *testing.T) {
+	gql := &fakeBulkGraphQL{}
+	runConcurrentBulkQueries(t, "2026-01", gql, 3, WithBulkConcurrency(1))
+	assert.Equal(t, 1, gql.maxRunning)
+
+	gql = &fakeBulkGraphQL{}
+	releasePostsOnceAllPosted(gql, 2)
+	runConcurrentBulkQueries(t, "2025-01", gql, 2, WithBulkConcurrency(2))
+	assert.Equal(t, 2, gql.maxRunning)
+}
+
+func TestSupportsConcurrentBulkOperations(t *testing.T) {
+	assert.False(t, supportsConcurrentBulkOperations(""))
+	assert.False(t, supportsConcurrentBulkOperations("2025-10"))
+	assert.False(t, supportsConcurrentBulkOperations("2026-1"))
+	assert.True(t, supportsConcurrentBulkOperations("2026-01"))
+	assert.True(t, supportsConcurrentBulkOperations("2027-04"))
+	assert.True(t, supportsConcurrentBulkOperations("unstable"))
+}
+
+func TestRunBulkOperationContextDone(t *testing.T) {
+	for _, cancelOnDone := range []bool{false, true} {
+		gql := &fakeBulkGraphQL{status: model.BulkOperationStatusRunning}
+		opts := []Option{WithGraphQLClient(gql), WithLogger(nil), WithBulkPollPolicy(PollPolicy{InitialInterval: 10 * time.Millisecond})}
+		if cancelOnDone {
+			opts = append(opts, WithBulkCancelOnContextDone())
+		}
+		c, err := NewClientE(opts...)
+		require.NoError(t, err)
+		c.apiVersion = "2026-01"
+		s := c.BulkOperation.(*BulkOperationServiceOp)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		_, err = s.runBulkOperation(ctx, trace.SpanFromContext(ctx), model.BulkOperationTypeQuery, postTestBulkQuery(s))
+		cancel()
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		if cancelOnDone {
+			assert.Equal(t, 1, gql.cancelled)
+		} else {
+			assert.Equal(t, 0, gql.cancelled)
+		}
+	}
+}
+
+func TestStartAndResumeBulkQuery(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testBulkResult))
+	}))
+	defer srv.Close()
+
+	gql := &fakeBulkGraphQL{url: srv.URL}
+	c, err := NewClientE(WithGraphQLClient(gql), WithLogger(nil))
+	require.NoError(t, err)
+
+	handle, err := c.BulkOperation.StartBulkQuery(context.Background(), "{ products { edges { node { id } } } }")
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/BulkOperation/1", handle.ID)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), handle.CreatedAt, "the creation time is the operation's")
+
+	// The handle survives a restart
+	data, err := json.Marshal(handle)
+	require.NoError(t, err)
+	var resumed BulkOperationHandle
+	require.NoError(t, json.Unmarshal(data, &resumed))
+
+	c, err = NewClientE(WithGraphQLClient(gql), WithLogger(nil))
+	require.NoError(t, err)
+	res := []model.Product{}
+	err = c.BulkOperation.ResumeBulkQuery(context.Background(), resumed, &res)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Len(t, res[0].Variants.Edges, 2)
+}
+
+func TestPollPolicyNext(t *testing.T) {
+	p := PollPolicy{InitialInterval: time.Second, MaxInterval: 3 * time.Second, Multiplier: 2}
+
+	interval := p.initial()
+	assert.Equal(t, time.Second, interval)
+	interval = p.next(interval)
+	assert.Equal(t, 2*time.Second, interval)
+	interval = p.next(interval)
+	assert.Equal(t, 3*time.Second, interval)
+
+	assert.Equal(t, 5*time.Second, fixedPollPolicy(5*time.Second).next(5*time.Second))
+}
@@ -0,0 +1,128 @@
+package shopify
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBulkResult = `{"id":"gid://shopify/Product/1","title":"T-Shirt"}
+{"id":"gid://shopify/ProductVariant/11","title":"Red","__parentId":"gid://shopify/Product/1"}
+{"id":"gid://shopify/ProductVariant/12","title":"Blue","__parentId":"gid://shopify/Product/1"}
+{"id":"gid://shopify/Metafield/111","key":"color","__parentId":"gid://shopify/ProductVariant/12"}
+{"id":"gid://shopify/Product/2","title":"Hoodie"}
+{"id":"gid://shopify/ProductVariant/21","title":"Black","__parentId":"gid://shopify/Product/2"}`
+
+func TestStreamBulkQueryResult(t *testing.T) {
+	res := []*model.Product{}
+	err := streamBulkQueryResult(strings.NewReader(testBulkResult), reflect.TypeOf(&model.Product{}), func(item reflect.Value) error {
+		res = append(res, item.Interface().(*model.Product))
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, res, 2)
+	assert.Equal(t, "T-Shirt", res[0].Title)
+	require.Len(t, res[0].Variants.Edges, 2)
+	assert.Equal(t, "Blue", res[0].Variants.Edges[1].Node.Title)
+	require.Len(t, res[0].Variants.Edges[1].Node.Metafields.Edges, 1)
+	assert.Equal(t, "color", res[0].Variants.Edges[1].Node.Metafields.Edges[0].Node.Key)
+
+	assert.Equal(t, "Hoodie", res[1].Title)
+	require.Len(t, res[1].Variants.Edges, 1)
+	assert.Equal(t, "Black", res[1].Variants.Edges[0].Node.Title)
+}
+
+func TestStreamBulkQueryResultStop(t *testing.T) {
+	errStop := errors.New("stop")
+	calls := 0
+	err := streamBulkQueryResult(strings.NewReader(testBulkResult), reflect.TypeOf(model.Product{}), func(item reflect.Value) error {
+		calls++
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, 1, calls)
+}
+
+func TestStreamBulkQueryResultInferredConnections(t *testing.T) {
+	type customOrder struct {
+		ID        string                    `json:"id"`
+		LineItems *model.LineItemConnection `json:"lineItems"`
+	}
+	type customCustomer struct {
+		ID     string `json:"id"`
+		Orders *struct {
+			Edges []struct {
+				Node *customOrder `json:"node"`
+			} `json:"edges"`
+		} `json:"orders"`
+	}
+
+	result := `{"id":"gid://shopify/Customer/1"}
+{"id":"gid://shopify/customOrder/2","__parentId":"gid://shopify/Customer/1"}
+{"id":"gid://shopify/LineItem/3","name":"Socks","__parentId":"gid://shopify/customOrder/2"}`
+
+	res := []customCustomer{}
+	err := streamBulkQueryResult(strings.NewReader(result), reflect.TypeOf(customCustomer{}), func(item reflect.Value) error {
+		res = append(res, item.Interface().(customCustomer))
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, res, 1)
+	require.Len(t, res[0].Orders.Edges, 1)
+	order := res[0].Orders.Edges[0].Node
+	assert.Equal(t, "gid://shopify/customOrder/2", order.ID)
+	require.Len(t, order.LineItems.Edges, 1)
+	assert.Equal(t, "Socks", order.LineItems.Edges[0].Node.Name)
+}
+
+func TestResolveConnectionType(t *testing.T) {
+	ct, err := resolveConnectionType(reflect.TypeOf(model.Product{}), "gid://shopify/SellingPlanGroup/1")
+	require.NoError(t, err)
+	assert.Equal(t, "SellingPlanGroups", ct.FieldName)
+	assert.Equal(t, reflect.TypeOf(&model.SellingPlanGroup{}), ct.NodeType)
+
+	ct, err = resolveConnectionType(reflect.TypeOf(model.Product{}), "gid://shopify/Video/1")
+	require.NoError(t, err)
+	assert.Equal(t, "Media", ct.FieldName)
+	assert.Equal(t, reflect.TypeOf(model.MediaEdge{}), ct.EdgeType)
+
+	ct, err = resolveConnectionType(nil, "gid://shopify/Customer/1")
+	require.NoError(t, err)
+	assert.Equal(t, "Customers", ct.FieldName)
+
+	_, err = resolveConnectionType(nil, "gid://shopify/Unknown/1")
+	assert.Error(t, err)
+}
+
+func TestReadBulkMutationResult(t *testing.T) {
+	result := `{"data":{"productUpdate":{"product":null,"userErrors":[{"field":["input","title"],"message":"Title can't be blank"}]}},"__lineNumber":1}
+{"data":{"productUpdate":{"product":{"id":"gid://shopify/Product/1"},"userErrors":[]}},"__lineNumber":0}
+`
+	res, err := readBulkMutationResult(strings.NewReader(result))
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	assert.Equal(t, 0, res[0].Line)
+	assert.NoError(t, res[0].Err())
+	var out struct {
+		ProductUpdate struct {
+			Product struct {
+				ID string `json:"id"`
+			} `json:"product"`
+		} `json:"productUpdate"`
+	}
+	require.NoError(t, res[0].Decode(&out))
+	assert.Equal(t, "gid://shopify/Product/1", out.ProductUpdate.Product.ID)
+
+	assert.Equal(t, 1, res[1].Line)
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, res[1].Err(), &userErrs)
+	assert.Equal(t, []string{"input", "title"}, userErrs.Errors[0].Field)
+}
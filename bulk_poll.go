@@ -0,0 +1,76 @@
+package shopify
+
+import (
+	"context"
+	"time"
+)
+
+// PollPolicy configures how often the status of a running bulk operation is checked.
+// The interval starts short for the quick operations and grows for the long ones.
+type PollPolicy struct {
+	// InitialInterval is the interval before the second status check.
+	InitialInterval time.Duration
+	// MaxInterval caps the interval.
+	MaxInterval time.Duration
+	// Multiplier grows the interval after every check. Values below 1 keep the interval fixed.
+	Multiplier float64
+}
+
+var defaultPollPolicy = PollPolicy{
+	InitialInterval: 500 * time.Millisecond,
+	MaxInterval:     30 * time.Second,
+	Multiplier:      1.5,
+}
+
+// fixedPollPolicy checks the status with the fixed interval.
+func fixedPollPolicy(interval time.Duration) PollPolicy {
+	return PollPolicy{
+		InitialInterval: interval,
+		MaxInterval:     interval,
+	}
+}
+
+// WithBulkPollPolicy sets the policy used to poll the bulk operations started by the client.
+func WithBulkPollPolicy(p PollPolicy) Option {
+	return func(c *Client) {
+		c.bulkPollPolicy = p
+	}
+}
+
+// WithBulkCancelOnContextDone makes the client cancel the bulk operation it started
+// when the context is cancelled or its deadline expires while waiting for the operation.
+func WithBulkCancelOnContextDone() Option {
+	return func(c *Client) {
+		c.bulkCancelOnContextDone = true
+	}
+}
+
+func (p PollPolicy) initial() time.Duration {
+	if p.InitialInterval <= 0 {
+		return defaultPollPolicy.InitialInterval
+	}
+	return p.InitialInterval
+}
+
+func (p PollPolicy) next(interval time.Duration) time.Duration {
+	if p.Multiplier > 1 {
+		interval = time.Duration(float64(interval) * p.Multiplier)
+	}
+	if p.MaxInterval > 0 && interval > p.MaxInterval {
+		interval = p.MaxInterval
+	}
+	return interval
+}
+
+// sleepContext waits for the duration or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -17,15 +17,20 @@ func TestBulkOperationEndToEnd(t *testing.T) {
 	require.NotZero(t, os.Getenv("STORE_NAME"))
 	require.NotZero(t, os.Getenv("STORE_ACCESS_TOKEN"))
 
+	defaultClient, err := shopify.NewDefaultClientE()
+	require.NoError(t, err)
+	clientWithToken, err := shopify.NewClientWithTokenE(os.Getenv("STORE_ACCESS_TOKEN"), os.Getenv("STORE_NAME"))
+	require.NoError(t, err)
+
 	tests := []struct {
 		name   string
 		client *shopify.Client
 	}{{
 		name:   "default client",
-		client: shopify.NewDefaultClient(),
+		client: defaultClient,
 	}, {
 		name:   "client with a token",
-		client: shopify.NewClientWithToken(os.Getenv("STORE_ACCESS_TOKEN"), os.Getenv("STORE_NAME")),
+		client: clientWithToken,
 	}}
 	for _, tt := range tests {
 		tt := tt
@@ -0,0 +1,216 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/r0busta/graphql/ident"
+)
+
+// BulkQueryConnection is implemented by the types queried with BulkQueryTyped whose top-level connection
+// isn't the plural of the type name, e.g. `func (Item) BulkQueryConnection() string { return "products" }`.
+type BulkQueryConnection interface {
+	BulkQueryConnection() string
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+const bulkQueryDocumentIndent = "\t"
+
+// BulkQueryTyped runs the bulk query of the top-level connection of T, filtered by the search query if not empty,
+// and returns its nodes. The query selects the fields of T the way the `Query` method of the GraphQL client does:
+// the fields are named after their `graphql` tag, including the arguments, or after the lower camel case field name.
+// A field tagged `graphql:"-"` is skipped.
+//
+// The nested connections are the struct fields with an `Edges` slice of edges with a `Node` field. Their nodes,
+// like the top-level ones, always select the `id` needed to attach them to their parent:
+//
+//	type Product struct {
+//		ID       string
+//		Title    string
+//		Variants struct {
+//			Edges []struct {
+//				Node ProductVariant
+//			}
+//		}
+//	}
+//
+//	products, err := shopify.BulkQueryTyped[Product](ctx, client.BulkOperation, "status:active")
+//
+// The top-level connection is the plural of the type name, e.g. `products`, unless T implements BulkQueryConnection.
+func BulkQueryTyped[T any](ctx context.Context, s BulkOperationService, filter string) ([]T, error) {
+	q, err := typedBulkQuery(reflect.TypeOf((*T)(nil)).Elem(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []T{}
+	err = s.BulkQuery(ctx, q, &res)
+	if err != nil {
+		return nil, fmt.Errorf("bulk query: %w", err)
+	}
+
+	return res, nil
+}
+
+// typedBulkQuery returns the bulk query document of the connection of the node type.
+func typedBulkQuery(t reflect.Type, filter string) (string, error) {
+	connection, err := typedBulkQueryConnection(t)
+	if err != nil {
+		return "", err
+	}
+
+	node := t
+	for node.Kind() == reflect.Ptr {
+		node = node.Elem()
+	}
+	if node.Kind() != reflect.Struct {
+		return "", fmt.Errorf("%s is not a struct", t)
+	}
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	b.WriteString(bulkQueryDocumentIndent + connection)
+	if filter != "" {
+		fmt.Fprintf(&b, "(query: %s)", graphQLString(filter))
+	}
+	b.WriteString("{\n")
+	err = writeBulkQueryConnectionNode(&b, node, 2, nil)
+	if err != nil {
+		return "", err
+	}
+	b.WriteString(bulkQueryDocumentIndent + "}\n}\n")
+
+	return b.String(), nil
+}
+
+func typedBulkQueryConnection(t reflect.Type) (string, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if c, ok := reflect.New(t).Interface().(BulkQueryConnection); ok {
+		return c.BulkQueryConnection(), nil
+	}
+
+	name := t.Name()
+	if name == "" || strings.Contains(name, "[") {
+		return "", fmt.Errorf("can't name the connection of %s, implement BulkQueryConnection", t)
+	}
+	return pluralize(ident.ParseMixedCaps(name).ToLowerCamelCase()), nil
+}
+
+// writeBulkQueryConnectionNode writes the `edges{node{...}}` selection of the connection node type.
+func writeBulkQueryConnectionNode(b *strings.Builder, node reflect.Type, depth int, visited []reflect.Type) error {
+	indent := strings.Repeat(bulkQueryDocumentIndent, depth)
+	b.WriteString(indent + "edges{\n")
+	b.WriteString(indent + bulkQueryDocumentIndent + "node{\n")
+
+	fields := &strings.Builder{}
+	hasID, hasConnections, err := writeBulkQueryFields(fields, node, depth+2, slices.Concat(visited, []reflect.Type{node}))
+	if err != nil {
+		return err
+	}
+	if !hasID {
+		if hasConnections {
+			return fmt.Errorf("%s has nested connections but no ID field", node)
+		}
+		b.WriteString(indent + strings.Repeat(bulkQueryDocumentIndent, 2) + "id\n")
+	}
+	b.WriteString(fields.String())
+
+	b.WriteString(indent + bulkQueryDocumentIndent + "}\n")
+	b.WriteString(indent + "}\n")
+	return nil
+}
+
+// writeBulkQueryFields writes the selection of the struct fields and reports whether they include the ID and nested connections.
+func writeBulkQueryFields(b *strings.Builder, t reflect.Type, depth int, visited []reflect.Type) (hasID, hasConnections bool, err error) {
+	indent := strings.Repeat(bulkQueryDocumentIndent, depth)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, tagged := field.Tag.Lookup("graphql")
+		if tag == "-" {
+			continue
+		}
+
+		fieldType := baseType(field.Type)
+		if field.Anonymous && !tagged && isSelectionStruct(fieldType) {
+			id, connections, err := writeBulkQueryFields(b, fieldType, depth, visited)
+			if err != nil {
+				return false, false, err
+			}
+			hasID, hasConnections = hasID || id, hasConnections || connections
+			continue
+		}
+		if slices.Contains(visited, fieldType) {
+			continue
+		}
+
+		name := tag
+		if !tagged {
+			name = ident.ParseMixedCaps(field.Name).ToLowerCamelCase()
+		}
+		if name == "id" {
+			hasID = true
+		}
+
+		if !isSelectionStruct(fieldType) {
+			b.WriteString(indent + name + "\n")
+			continue
+		}
+
+		b.WriteString(indent + name + "{\n")
+		if _, node, ok := connectionFieldTypes(fieldType); ok {
+			hasConnections = true
+			err := writeBulkQueryConnectionNode(b, baseType(node), depth+1, slices.Concat(visited, []reflect.Type{fieldType}))
+			if err != nil {
+				return false, false, err
+			}
+		} else {
+			_, connections, err := writeBulkQueryFields(b, fieldType, depth+1, slices.Concat(visited, []reflect.Type{fieldType}))
+			if err != nil {
+				return false, false, err
+			}
+			if connections {
+				return false, false, fmt.Errorf("the connections nested in the %s object aren't supported in bulk queries", name)
+			}
+		}
+		b.WriteString(indent + "}\n")
+	}
+
+	return hasID, hasConnections, nil
+}
+
+// baseType returns the element type of the pointer and slice types.
+func baseType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	return t
+}
+
+// isSelectionStruct reports whether the type is an object with a selection of fields rather than a scalar, e.g. a time.Time.
+func isSelectionStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && !reflect.PointerTo(t).Implements(jsonUnmarshalerType) && t.NumField() > 0
+}
+
+// pluralize returns the plural of the English noun, e.g. `companies` for `company`.
+func pluralize(s string) string {
+	switch {
+	case strings.HasSuffix(s, "y") && len(s) > 1 && !strings.ContainsAny(s[len(s)-2:len(s)-1], "aeiou"):
+		return s[:len(s)-1] + "ies"
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "ch"), strings.HasSuffix(s, "sh"):
+		return s + "es"
+	}
+	return s + "s"
+}
@@ -0,0 +1,77 @@
+package shopify
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type typedVariant struct {
+	ID         string
+	Title      string
+	Metafields struct {
+		Edges []struct {
+			Node struct {
+				Key string
+			}
+		}
+	} `graphql:"metafields(namespace: \"custom\")"`
+}
+
+type typedProduct struct {
+	ID        string
+	Title     string
+	UpdatedAt time.Time
+	SEO       struct {
+		Title string
+	} `graphql:"seo"`
+	Internal string `graphql:"-"`
+	Variants *struct {
+		Edges []struct {
+			Node *typedVariant
+		}
+	}
+}
+
+func (typedProduct) BulkQueryConnection() string {
+	return "products"
+}
+
+type Company struct {
+	Name string
+}
+
+func reflectTypeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func TestTypedBulkQuery(t *testing.T) {
+	q, err := typedBulkQuery(reflectTypeOf[typedProduct](), `title:"a"`)
+	require.NoError(t, err)
+	assert.Equal(t, `{ products(query: "title:\"a\""){ edges{ node{ id title updatedAt seo{ title } variants{ edges{ node{ id title metafields(namespace: "custom"){ edges{ node{ id key } } } } } } } } } }`,
+		strings.Join(strings.Fields(q), " "))
+
+	q, err = typedBulkQuery(reflectTypeOf[*Company](), "")
+	require.NoError(t, err)
+	assert.Equal(t, `{ companies{ edges{ node{ id name } } } }`, strings.Join(strings.Fields(q), " "))
+
+	_, err = typedBulkQuery(reflectTypeOf[struct{ Title string }](), "")
+	assert.Error(t, err)
+}
+
+func TestTypedBulkQueryResult(t *testing.T) {
+	res := []typedProduct{}
+	err := readBulkQueryResult(strings.NewReader(testBulkResult), &res)
+	require.NoError(t, err)
+
+	require.Len(t, res, 2)
+	require.Len(t, res[0].Variants.Edges, 2)
+	assert.Equal(t, "Blue", res[0].Variants.Edges[1].Node.Title)
+	require.Len(t, res[0].Variants.Edges[1].Node.Metafields.Edges, 1)
+	assert.Equal(t, "color", res[0].Variants.Edges[1].Node.Metafields.Edges[0].Node.Key)
+	require.Len(t, res[1].Variants.Edges, 1)
+}
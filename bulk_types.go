@@ -0,0 +1,187 @@
+package shopify
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+)
+
+//go:generate go run ./internal/cmd/genconnectiontypes -o bulk_types_gen.go
+
+// ConnectionType describes how the objects of a GID resource are attached to their parent
+// when they are read from the bulk query result as a nested connection.
+type ConnectionType struct {
+	// EdgeType is the edge type of the connection, e.g. model.ProductVariantEdge.
+	EdgeType reflect.Type
+	// NodeType is the type the objects are unmarshalled into, e.g. *model.ProductVariant.
+	NodeType reflect.Type
+	// FieldName is the connection field of the parent, e.g. Variants.
+	FieldName string
+}
+
+type resolvedConnectionKey struct {
+	parentType reflect.Type
+	resource   string
+}
+
+var connectionTypes = struct {
+	sync.RWMutex
+	registered map[string]ConnectionType
+	resolved   map[resolvedConnectionKey]ConnectionType
+}{
+	registered: map[string]ConnectionType{},
+	resolved:   map[resolvedConnectionKey]ConnectionType{},
+}
+
+func init() {
+	registerModelConnectionTypes()
+
+	// The resources whose connection field names don't follow the type name
+	RegisterConnectionType("ProductVariant", model.ProductVariantEdge{}, &model.ProductVariant{}, "Variants")
+	RegisterConnectionType("ProductImage", model.ImageEdge{}, &model.Image{}, "Images")
+	RegisterConnectionType("FulfillmentOrderLineItem", model.FulfillmentOrderLineItemEdge{}, &model.FulfillmentOrderLineItem{}, "LineItems")
+	RegisterConnectionType("MediaImage", model.MediaEdge{}, &model.MediaImage{}, "Media")
+	RegisterConnectionType("Video", model.MediaEdge{}, &model.Video{}, "Media")
+	RegisterConnectionType("Model3d", model.MediaEdge{}, &model.Model3d{}, "Media")
+	RegisterConnectionType("ExternalVideo", model.MediaEdge{}, &model.ExternalVideo{}, "Media")
+}
+
+// RegisterConnectionType registers the edge type, the node type and the connection field name used to parse
+// the nested objects of the GID resource (e.g. `ProductVariant` for `gid://shopify/ProductVariant/1`) in the bulk query results.
+//
+// The types of all the connections of the model package are registered by default. Registering is only needed
+// for custom node types or when the parent type has several connection fields accepting the node,
+// otherwise the connection field is inferred from the parent type. The edge can be nil if the connection field is always inferred.
+func RegisterConnectionType(resource string, edge interface{}, node interface{}, fieldName string) {
+	ct := ConnectionType{
+		EdgeType:  reflect.TypeOf(edge),
+		NodeType:  reflect.TypeOf(node),
+		FieldName: fieldName,
+	}
+
+	connectionTypes.Lock()
+	defer connectionTypes.Unlock()
+
+	connectionTypes.registered[resource] = ct
+	clear(connectionTypes.resolved)
+}
+
+// LookupConnectionType returns the connection type registered for the GID resource.
+func LookupConnectionType(resource string) (ConnectionType, bool) {
+	connectionTypes.RLock()
+	defer connectionTypes.RUnlock()
+
+	ct, ok := connectionTypes.registered[resource]
+	return ct, ok
+}
+
+// resolveConnectionType returns the connection type of the object with the gid nested in a parent of the parent type.
+// The edge type and the connection field are inferred from the fields of the parent type, the registered ones are only
+// used when no field accepts the node or the parent type is unknown.
+func resolveConnectionType(parentType reflect.Type, gid string) (ConnectionType, error) {
+	submatches := gidRegex.FindStringSubmatch(gid)
+	if len(submatches) != 2 {
+		return ConnectionType{}, fmt.Errorf("malformed gid=`%s`", gid)
+	}
+	resource := submatches[1]
+
+	key := resolvedConnectionKey{parentType: parentType, resource: resource}
+
+	connectionTypes.RLock()
+	ct, ok := connectionTypes.resolved[key]
+	registered, isRegistered := connectionTypes.registered[resource]
+	connectionTypes.RUnlock()
+	if ok {
+		return ct, nil
+	}
+
+	ct, ok = inferConnectionType(parentType, resource, registered, isRegistered)
+	if !ok {
+		if !isRegistered || registered.EdgeType == nil || registered.FieldName == "" {
+			return ConnectionType{}, fmt.Errorf("`%s` not implemented type, register it with RegisterConnectionType", resource)
+		}
+		ct = registered
+	}
+
+	connectionTypes.Lock()
+	connectionTypes.resolved[key] = ct
+	connectionTypes.Unlock()
+
+	return ct, nil
+}
+
+// inferConnectionType looks up the connection field of the parent type whose node accepts the registered node type,
+// or, for a not registered resource, whose node is the struct named after the resource or, failing that, the only connection field.
+// The field with the registered name wins if several fields match.
+func inferConnectionType(parentType reflect.Type, resource string, registered ConnectionType, isRegistered bool) (ConnectionType, bool) {
+	if parentType == nil || parentType.Kind() != reflect.Struct {
+		return ConnectionType{}, false
+	}
+
+	var candidates, others []ConnectionType
+	for i := 0; i < parentType.NumField(); i++ {
+		field := parentType.Field(i)
+		edgeType, nodeType, ok := connectionFieldTypes(field.Type)
+		if !ok {
+			continue
+		}
+
+		ct := ConnectionType{EdgeType: edgeType, FieldName: field.Name}
+		switch {
+		case isRegistered && registered.NodeType != nil && registered.NodeType.AssignableTo(nodeType):
+			ct.NodeType = registered.NodeType
+		case baseType(nodeType).Kind() == reflect.Struct && baseType(nodeType).Name() == resource:
+			ct.NodeType = nodeType
+		default:
+			others = append(others, ConnectionType{EdgeType: edgeType, NodeType: nodeType, FieldName: field.Name})
+			continue
+		}
+
+		if isRegistered && field.Name == registered.FieldName {
+			return ct, true
+		}
+		candidates = append(candidates, ct)
+	}
+
+	if len(candidates) == 0 {
+		// The only connection of a struct, e.g. one queried with BulkQueryTyped, whose node isn't named after the resource
+		if len(others) == 1 {
+			return others[0], true
+		}
+		return ConnectionType{}, false
+	}
+	return candidates[0], true
+}
+
+// connectionFieldTypes returns the edge and node types of a connection field type, e.g. `*model.ProductVariantConnection`.
+func connectionFieldTypes(t reflect.Type) (reflect.Type, reflect.Type, bool) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, nil, false
+	}
+
+	edges, ok := t.FieldByName(edgesFieldName)
+	if !ok || edges.Type.Kind() != reflect.Slice {
+		return nil, nil, false
+	}
+	edgeType := edges.Type.Elem()
+
+	edgeStruct := edgeType
+	if edgeStruct.Kind() == reflect.Ptr {
+		edgeStruct = edgeStruct.Elem()
+	}
+	if edgeStruct.Kind() != reflect.Struct {
+		return nil, nil, false
+	}
+
+	node, ok := edgeStruct.FieldByName(nodeFieldName)
+	if !ok {
+		return nil, nil, false
+	}
+
+	return edgeType, node.Type, true
+}
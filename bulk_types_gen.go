@@ -0,0 +1,165 @@
+// Code generated by genconnectiontypes. DO NOT EDIT.
+
+package shopify
+
+import "github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+
+func registerModelConnectionTypes() {
+	RegisterConnectionType("AbandonedCheckout", model.AbandonedCheckoutEdge{}, &model.AbandonedCheckout{}, "AbandonedCheckouts")
+	RegisterConnectionType("AbandonedCheckoutLineItem", model.AbandonedCheckoutLineItemEdge{}, &model.AbandonedCheckoutLineItem{}, "AbandonedCheckoutLineItems")
+	RegisterConnectionType("App", model.AppEdge{}, &model.App{}, "Apps")
+	RegisterConnectionType("AppCredit", model.AppCreditEdge{}, &model.AppCredit{}, "AppCredits")
+	RegisterConnectionType("AppInstallation", model.AppInstallationEdge{}, &model.AppInstallation{}, "AppInstallations")
+	RegisterConnectionType("AppPurchaseOneTime", model.AppPurchaseOneTimeEdge{}, &model.AppPurchaseOneTime{}, "AppPurchaseOneTimes")
+	RegisterConnectionType("AppRevenueAttributionRecord", model.AppRevenueAttributionRecordEdge{}, &model.AppRevenueAttributionRecord{}, "AppRevenueAttributionRecords")
+	RegisterConnectionType("AppSubscription", model.AppSubscriptionEdge{}, &model.AppSubscription{}, "AppSubscriptions")
+	RegisterConnectionType("AppUsageRecord", model.AppUsageRecordEdge{}, &model.AppUsageRecord{}, "AppUsageRecords")
+	RegisterConnectionType("Article", model.ArticleEdge{}, &model.Article{}, "Articles")
+	RegisterConnectionType("Blog", model.BlogEdge{}, &model.Blog{}, "Blogs")
+	RegisterConnectionType("CalculatedLineItem", model.CalculatedLineItemEdge{}, &model.CalculatedLineItem{}, "CalculatedLineItems")
+	RegisterConnectionType("CartTransform", model.CartTransformEdge{}, &model.CartTransform{}, "CartTransforms")
+	RegisterConnectionType("CashTrackingAdjustment", model.CashTrackingAdjustmentEdge{}, &model.CashTrackingAdjustment{}, "CashTrackingAdjustments")
+	RegisterConnectionType("CashTrackingSession", model.CashTrackingSessionEdge{}, &model.CashTrackingSession{}, "CashTrackingSessions")
+	RegisterConnectionType("Channel", model.ChannelEdge{}, &model.Channel{}, "Channels")
+	RegisterConnectionType("CheckoutProfile", model.CheckoutProfileEdge{}, &model.CheckoutProfile{}, "CheckoutProfiles")
+	RegisterConnectionType("Collection", model.CollectionEdge{}, &model.Collection{}, "Collections")
+	RegisterConnectionType("Comment", model.CommentEdge{}, &model.Comment{}, "Comments")
+	RegisterConnectionType("Company", model.CompanyEdge{}, &model.Company{}, "Companies")
+	RegisterConnectionType("CompanyContact", model.CompanyContactEdge{}, &model.CompanyContact{}, "CompanyContacts")
+	RegisterConnectionType("CompanyContactRole", model.CompanyContactRoleEdge{}, &model.CompanyContactRole{}, "CompanyContactRoles")
+	RegisterConnectionType("CompanyContactRoleAssignment", model.CompanyContactRoleAssignmentEdge{}, &model.CompanyContactRoleAssignment{}, "CompanyContactRoleAssignments")
+	RegisterConnectionType("CompanyLocation", model.CompanyLocationEdge{}, &model.CompanyLocation{}, "CompanyLocations")
+	RegisterConnectionType("CompanyLocationStaffMemberAssignment", model.CompanyLocationStaffMemberAssignmentEdge{}, &model.CompanyLocationStaffMemberAssignment{}, "CompanyLocationStaffMemberAssignments")
+	RegisterConnectionType("Customer", model.CustomerEdge{}, &model.Customer{}, "Customers")
+	RegisterConnectionType("CustomerPaymentMethod", model.CustomerPaymentMethodEdge{}, &model.CustomerPaymentMethod{}, "CustomerPaymentMethods")
+	RegisterConnectionType("CustomerSegmentMember", model.CustomerSegmentMemberEdge{}, &model.CustomerSegmentMember{}, "CustomerSegmentMembers")
+	RegisterConnectionType("DeliveryCarrierService", model.DeliveryCarrierServiceEdge{}, &model.DeliveryCarrierService{}, "DeliveryCarrierServices")
+	RegisterConnectionType("DeliveryCustomization", model.DeliveryCustomizationEdge{}, &model.DeliveryCustomization{}, "DeliveryCustomizations")
+	RegisterConnectionType("DeliveryMethodDefinition", model.DeliveryMethodDefinitionEdge{}, &model.DeliveryMethodDefinition{}, "DeliveryMethodDefinitions")
+	RegisterConnectionType("DeliveryProfile", model.DeliveryProfileEdge{}, &model.DeliveryProfile{}, "DeliveryProfiles")
+	RegisterConnectionType("DeliveryProfileItem", model.DeliveryProfileItemEdge{}, &model.DeliveryProfileItem{}, "DeliveryProfileItems")
+	RegisterConnectionType("DiscountAutomaticNode", model.DiscountAutomaticNodeEdge{}, &model.DiscountAutomaticNode{}, "DiscountAutomaticNodes")
+	RegisterConnectionType("DiscountCodeNode", model.DiscountCodeNodeEdge{}, &model.DiscountCodeNode{}, "DiscountCodeNodes")
+	RegisterConnectionType("DiscountNode", model.DiscountNodeEdge{}, &model.DiscountNode{}, "DiscountNodes")
+	RegisterConnectionType("DiscountRedeemCode", model.DiscountRedeemCodeEdge{}, &model.DiscountRedeemCode{}, "DiscountRedeemCodes")
+	RegisterConnectionType("DraftOrder", model.DraftOrderEdge{}, &model.DraftOrder{}, "DraftOrders")
+	RegisterConnectionType("DraftOrderLineItem", model.DraftOrderLineItemEdge{}, &model.DraftOrderLineItem{}, "DraftOrderLineItems")
+	RegisterConnectionType("ExchangeLineItem", model.ExchangeLineItemEdge{}, &model.ExchangeLineItem{}, "ExchangeLineItems")
+	RegisterConnectionType("ExchangeV2", model.ExchangeV2Edge{}, &model.ExchangeV2{}, "ExchangeV2s")
+	RegisterConnectionType("Fulfillment", model.FulfillmentEdge{}, &model.Fulfillment{}, "Fulfillments")
+	RegisterConnectionType("FulfillmentEvent", model.FulfillmentEventEdge{}, &model.FulfillmentEvent{}, "FulfillmentEvents")
+	RegisterConnectionType("FulfillmentLineItem", model.FulfillmentLineItemEdge{}, &model.FulfillmentLineItem{}, "FulfillmentLineItems")
+	RegisterConnectionType("FulfillmentOrder", model.FulfillmentOrderEdge{}, &model.FulfillmentOrder{}, "FulfillmentOrders")
+	RegisterConnectionType("FulfillmentOrderLineItem", model.FulfillmentOrderLineItemEdge{}, &model.FulfillmentOrderLineItem{}, "FulfillmentOrderLineItems")
+	RegisterConnectionType("FulfillmentOrderMerchantRequest", model.FulfillmentOrderMerchantRequestEdge{}, &model.FulfillmentOrderMerchantRequest{}, "FulfillmentOrderMerchantRequests")
+	RegisterConnectionType("GiftCard", model.GiftCardEdge{}, &model.GiftCard{}, "GiftCards")
+	RegisterConnectionType("InventoryItem", model.InventoryItemEdge{}, &model.InventoryItem{}, "InventoryItems")
+	RegisterConnectionType("InventoryLevel", model.InventoryLevelEdge{}, &model.InventoryLevel{}, "InventoryLevels")
+	RegisterConnectionType("LineItem", model.LineItemEdge{}, &model.LineItem{}, "LineItems")
+	RegisterConnectionType("Location", model.LocationEdge{}, &model.Location{}, "Locations")
+	RegisterConnectionType("MailingAddress", model.MailingAddressEdge{}, &model.MailingAddress{}, "MailingAddresses")
+	RegisterConnectionType("Market", model.MarketEdge{}, &model.Market{}, "Markets")
+	RegisterConnectionType("MarketCatalog", model.MarketCatalogEdge{}, &model.MarketCatalog{}, "MarketCatalogs")
+	RegisterConnectionType("MarketWebPresence", model.MarketWebPresenceEdge{}, &model.MarketWebPresence{}, "MarketWebPresences")
+	RegisterConnectionType("MarketingActivity", model.MarketingActivityEdge{}, &model.MarketingActivity{}, "MarketingActivities")
+	RegisterConnectionType("MarketingEvent", model.MarketingEventEdge{}, &model.MarketingEvent{}, "MarketingEvents")
+	RegisterConnectionType("Menu", model.MenuEdge{}, &model.Menu{}, "Menus")
+	RegisterConnectionType("Metafield", model.MetafieldEdge{}, &model.Metafield{}, "Metafields")
+	RegisterConnectionType("MetafieldDefinition", model.MetafieldDefinitionEdge{}, &model.MetafieldDefinition{}, "MetafieldDefinitions")
+	RegisterConnectionType("Metaobject", model.MetaobjectEdge{}, &model.Metaobject{}, "Metaobjects")
+	RegisterConnectionType("MetaobjectDefinition", model.MetaobjectDefinitionEdge{}, &model.MetaobjectDefinition{}, "MetaobjectDefinitions")
+	RegisterConnectionType("OnlineStoreTheme", model.OnlineStoreThemeEdge{}, &model.OnlineStoreTheme{}, "OnlineStoreThemes")
+	RegisterConnectionType("Order", model.OrderEdge{}, &model.Order{}, "Orders")
+	RegisterConnectionType("OrderAdjustment", model.OrderAdjustmentEdge{}, &model.OrderAdjustment{}, "OrderAdjustments")
+	RegisterConnectionType("OrderTransaction", model.OrderTransactionEdge{}, &model.OrderTransaction{}, "OrderTransactions")
+	RegisterConnectionType("Page", model.PageEdge{}, &model.Page{}, "Pages")
+	RegisterConnectionType("PaymentCustomization", model.PaymentCustomizationEdge{}, &model.PaymentCustomization{}, "PaymentCustomizations")
+	RegisterConnectionType("PaymentSchedule", model.PaymentScheduleEdge{}, &model.PaymentSchedule{}, "PaymentSchedules")
+	RegisterConnectionType("PriceList", model.PriceListEdge{}, &model.PriceList{}, "PriceLists")
+	RegisterConnectionType("PriceRuleDiscountCode", model.PriceRuleDiscountCodeEdge{}, &model.PriceRuleDiscountCode{}, "PriceRuleDiscountCodes")
+	RegisterConnectionType("PrivateMetafield", model.PrivateMetafieldEdge{}, &model.PrivateMetafield{}, "PrivateMetafields")
+	RegisterConnectionType("Product", model.ProductEdge{}, &model.Product{}, "Products")
+	RegisterConnectionType("ProductFeed", model.ProductFeedEdge{}, &model.ProductFeed{}, "ProductFeeds")
+	RegisterConnectionType("ProductVariant", model.ProductVariantEdge{}, &model.ProductVariant{}, "ProductVariants")
+	RegisterConnectionType("ProductVariantComponent", model.ProductVariantComponentEdge{}, &model.ProductVariantComponent{}, "ProductVariantComponents")
+	RegisterConnectionType("Publication", model.PublicationEdge{}, &model.Publication{}, "Publications")
+	RegisterConnectionType("QuantityPriceBreak", model.QuantityPriceBreakEdge{}, &model.QuantityPriceBreak{}, "QuantityPriceBreaks")
+	RegisterConnectionType("Refund", model.RefundEdge{}, &model.Refund{}, "Refunds")
+	RegisterConnectionType("RefundShippingLine", model.RefundShippingLineEdge{}, &model.RefundShippingLine{}, "RefundShippingLines")
+	RegisterConnectionType("Return", model.ReturnEdge{}, &model.Return{}, "Returns")
+	RegisterConnectionType("ReturnableFulfillment", model.ReturnableFulfillmentEdge{}, &model.ReturnableFulfillment{}, "ReturnableFulfillments")
+	RegisterConnectionType("ReverseDelivery", model.ReverseDeliveryEdge{}, &model.ReverseDelivery{}, "ReverseDeliveries")
+	RegisterConnectionType("ReverseDeliveryLineItem", model.ReverseDeliveryLineItemEdge{}, &model.ReverseDeliveryLineItem{}, "ReverseDeliveryLineItems")
+	RegisterConnectionType("ReverseFulfillmentOrder", model.ReverseFulfillmentOrderEdge{}, &model.ReverseFulfillmentOrder{}, "ReverseFulfillmentOrders")
+	RegisterConnectionType("ReverseFulfillmentOrderLineItem", model.ReverseFulfillmentOrderLineItemEdge{}, &model.ReverseFulfillmentOrderLineItem{}, "ReverseFulfillmentOrderLineItems")
+	RegisterConnectionType("SavedSearch", model.SavedSearchEdge{}, &model.SavedSearch{}, "SavedSearches")
+	RegisterConnectionType("ScriptTag", model.ScriptTagEdge{}, &model.ScriptTag{}, "ScriptTags")
+	RegisterConnectionType("Segment", model.SegmentEdge{}, &model.Segment{}, "Segments")
+	RegisterConnectionType("SegmentMigration", model.SegmentMigrationEdge{}, &model.SegmentMigration{}, "SegmentMigrations")
+	RegisterConnectionType("SellingPlan", model.SellingPlanEdge{}, &model.SellingPlan{}, "SellingPlans")
+	RegisterConnectionType("SellingPlanGroup", model.SellingPlanGroupEdge{}, &model.SellingPlanGroup{}, "SellingPlanGroups")
+	RegisterConnectionType("ShopifyFunction", model.ShopifyFunctionEdge{}, &model.ShopifyFunction{}, "ShopifyFunctions")
+	RegisterConnectionType("ShopifyPaymentsBalanceTransaction", model.ShopifyPaymentsBalanceTransactionEdge{}, &model.ShopifyPaymentsBalanceTransaction{}, "ShopifyPaymentsBalanceTransactions")
+	RegisterConnectionType("ShopifyPaymentsBankAccount", model.ShopifyPaymentsBankAccountEdge{}, &model.ShopifyPaymentsBankAccount{}, "ShopifyPaymentsBankAccounts")
+	RegisterConnectionType("ShopifyPaymentsDispute", model.ShopifyPaymentsDisputeEdge{}, &model.ShopifyPaymentsDispute{}, "ShopifyPaymentsDisputes")
+	RegisterConnectionType("ShopifyPaymentsPayout", model.ShopifyPaymentsPayoutEdge{}, &model.ShopifyPaymentsPayout{}, "ShopifyPaymentsPayouts")
+	RegisterConnectionType("StaffMember", model.StaffMemberEdge{}, &model.StaffMember{}, "StaffMembers")
+	RegisterConnectionType("StandardMetafieldDefinitionTemplate", model.StandardMetafieldDefinitionTemplateEdge{}, &model.StandardMetafieldDefinitionTemplate{}, "StandardMetafieldDefinitionTemplates")
+	RegisterConnectionType("StoreCreditAccount", model.StoreCreditAccountEdge{}, &model.StoreCreditAccount{}, "StoreCreditAccounts")
+	RegisterConnectionType("StorefrontAccessToken", model.StorefrontAccessTokenEdge{}, &model.StorefrontAccessToken{}, "StorefrontAccessTokens")
+	RegisterConnectionType("SubscriptionBillingAttempt", model.SubscriptionBillingAttemptEdge{}, &model.SubscriptionBillingAttempt{}, "SubscriptionBillingAttempts")
+	RegisterConnectionType("SubscriptionContract", model.SubscriptionContractEdge{}, &model.SubscriptionContract{}, "SubscriptionContracts")
+	RegisterConnectionType("SubscriptionLine", model.SubscriptionLineEdge{}, &model.SubscriptionLine{}, "SubscriptionLines")
+	RegisterConnectionType("SubscriptionManualDiscount", model.SubscriptionManualDiscountEdge{}, &model.SubscriptionManualDiscount{}, "SubscriptionManualDiscounts")
+	RegisterConnectionType("TaxonomyCategory", model.TaxonomyCategoryEdge{}, &model.TaxonomyCategory{}, "TaxonomyCategories")
+	RegisterConnectionType("TaxonomyValue", model.TaxonomyValueEdge{}, &model.TaxonomyValue{}, "TaxonomyValues")
+	RegisterConnectionType("TenderTransaction", model.TenderTransactionEdge{}, &model.TenderTransaction{}, "TenderTransactions")
+	RegisterConnectionType("URLRedirect", model.URLRedirectEdge{}, &model.URLRedirect{}, "URLRedirects")
+	RegisterConnectionType("Validation", model.ValidationEdge{}, &model.Validation{}, "Validations")
+	RegisterConnectionType("WebhookSubscription", model.WebhookSubscriptionEdge{}, &model.WebhookSubscription{}, "WebhookSubscriptions")
+	RegisterConnectionType("AdditionalFeeSale", nil, &model.AdditionalFeeSale{}, "")
+	RegisterConnectionType("AdjustmentSale", nil, &model.AdjustmentSale{}, "")
+	RegisterConnectionType("AndroidApplication", nil, &model.AndroidApplication{}, "")
+	RegisterConnectionType("AppCatalog", nil, &model.AppCatalog{}, "")
+	RegisterConnectionType("AppleApplication", nil, &model.AppleApplication{}, "")
+	RegisterConnectionType("BasicEvent", nil, &model.BasicEvent{}, "")
+	RegisterConnectionType("CalculatedAutomaticDiscountApplication", nil, &model.CalculatedAutomaticDiscountApplication{}, "")
+	RegisterConnectionType("CalculatedDiscountCodeApplication", nil, &model.CalculatedDiscountCodeApplication{}, "")
+	RegisterConnectionType("CalculatedManualDiscountApplication", nil, &model.CalculatedManualDiscountApplication{}, "")
+	RegisterConnectionType("CalculatedScriptDiscountApplication", nil, &model.CalculatedScriptDiscountApplication{}, "")
+	RegisterConnectionType("CommentEvent", nil, &model.CommentEvent{}, "")
+	RegisterConnectionType("CompanyLocationCatalog", nil, &model.CompanyLocationCatalog{}, "")
+	RegisterConnectionType("CustomerAccountAppExtensionPage", nil, &model.CustomerAccountAppExtensionPage{}, "")
+	RegisterConnectionType("CustomerAccountNativePage", nil, &model.CustomerAccountNativePage{}, "")
+	RegisterConnectionType("CustomerVisit", nil, &model.CustomerVisit{}, "")
+	RegisterConnectionType("DiscountAutomaticBxgy", nil, &model.DiscountAutomaticBxgy{}, "")
+	RegisterConnectionType("DutySale", nil, &model.DutySale{}, "")
+	RegisterConnectionType("ExternalVideo", nil, &model.ExternalVideo{}, "")
+	RegisterConnectionType("FeeSale", nil, &model.FeeSale{}, "")
+	RegisterConnectionType("GenericFile", nil, &model.GenericFile{}, "")
+	RegisterConnectionType("GiftCardCreditTransaction", nil, &model.GiftCardCreditTransaction{}, "")
+	RegisterConnectionType("GiftCardDebitTransaction", nil, &model.GiftCardDebitTransaction{}, "")
+	RegisterConnectionType("GiftCardSale", nil, &model.GiftCardSale{}, "")
+	RegisterConnectionType("MarketRegionCountry", nil, &model.MarketRegionCountry{}, "")
+	RegisterConnectionType("MediaImage", nil, &model.MediaImage{}, "")
+	RegisterConnectionType("Model3d", nil, &model.Model3d{}, "")
+	RegisterConnectionType("OrderAgreement", nil, &model.OrderAgreement{}, "")
+	RegisterConnectionType("OrderEditAgreement", nil, &model.OrderEditAgreement{}, "")
+	RegisterConnectionType("OrderStagedChangeAddLineItemDiscount", nil, &model.OrderStagedChangeAddLineItemDiscount{}, "")
+	RegisterConnectionType("ProductSale", nil, &model.ProductSale{}, "")
+	RegisterConnectionType("RefundAgreement", nil, &model.RefundAgreement{}, "")
+	RegisterConnectionType("ReturnAgreement", nil, &model.ReturnAgreement{}, "")
+	RegisterConnectionType("ReturnLineItem", nil, &model.ReturnLineItem{}, "")
+	RegisterConnectionType("ShippingLineSale", nil, &model.ShippingLineSale{}, "")
+	RegisterConnectionType("StoreCreditAccountCreditTransaction", nil, &model.StoreCreditAccountCreditTransaction{}, "")
+	RegisterConnectionType("StoreCreditAccountDebitRevertTransaction", nil, &model.StoreCreditAccountDebitRevertTransaction{}, "")
+	RegisterConnectionType("StoreCreditAccountDebitTransaction", nil, &model.StoreCreditAccountDebitTransaction{}, "")
+	RegisterConnectionType("SubscriptionAppliedCodeDiscount", nil, &model.SubscriptionAppliedCodeDiscount{}, "")
+	RegisterConnectionType("TaxonomyAttribute", nil, &model.TaxonomyAttribute{}, "")
+	RegisterConnectionType("TaxonomyChoiceListAttribute", nil, &model.TaxonomyChoiceListAttribute{}, "")
+	RegisterConnectionType("TaxonomyMeasurementAttribute", nil, &model.TaxonomyMeasurementAttribute{}, "")
+	RegisterConnectionType("TipSale", nil, &model.TipSale{}, "")
+	RegisterConnectionType("UnknownSale", nil, &model.UnknownSale{}, "")
+	RegisterConnectionType("UnverifiedReturnLineItem", nil, &model.UnverifiedReturnLineItem{}, "")
+	RegisterConnectionType("Video", nil, &model.Video{}, "")
+}
@@ -0,0 +1,294 @@
+package shopify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+)
+
+const (
+	bulkOperationsFinishTopic = "bulk_operations/finish"
+
+	defaultBulkWebhookTimeout = 1 * time.Hour
+	// bulkWebhookRetention is how long a webhook that arrived before its operation is waited for is kept.
+	bulkWebhookRetention = 1 * time.Hour
+	maxWebhookBodySize   = 1 << 20
+)
+
+// BulkFinishWebhook is the http.Handler of the `bulk_operations/finish` webhook topic. It resumes the bulk operations
+// of the clients configured with WithBulkFinishWebhook as soon as Shopify notifies they are finished,
+// so that they don't have to be polled.
+//
+// The handler must be served at the callback URL of the webhook subscription. The operations whose webhook is
+// delivered to another process are polled once the webhook timeout is reached.
+type BulkFinishWebhook struct {
+	secret string
+
+	mu       sync.Mutex
+	finishes map[string]*bulkFinish
+}
+
+type bulkFinish struct {
+	done       chan struct{}
+	finished   bool
+	receivedAt time.Time
+}
+
+// bulkFinishPayload is the payload of the `bulk_operations/finish` webhook.
+type bulkFinishPayload struct {
+	AdminGraphqlAPIID string `json:"admin_graphql_api_id"`
+	Status            string `json:"status"`
+	ErrorCode         string `json:"error_code"`
+	Type              string `json:"type"`
+}
+
+var _ http.Handler = &BulkFinishWebhook{}
+
+// NewBulkFinishWebhook creates the webhook handler verifying the webhooks are signed with the app client secret.
+// With an empty secret, every webhook is rejected and the clients poll the bulk operations instead.
+func NewBulkFinishWebhook(secret string) *BulkFinishWebhook {
+	return &BulkFinishWebhook{
+		secret:   secret,
+		finishes: make(map[string]*bulkFinish),
+	}
+}
+
+// WithBulkFinishWebhook makes the client wait for the bulk operations to be finished via the webhook handler,
+// falling back to polling if the webhook doesn't arrive within the timeout (one hour if zero).
+// If the callback URL is set, the client subscribes it to the `BULK_OPERATIONS_FINISH` topic before the first bulk operation.
+// The webhook handler must be created with the app client secret, otherwise the bulk operations are polled.
+func WithBulkFinishWebhook(hook *BulkFinishWebhook, callbackURL string, timeout time.Duration) Option {
+	return func(c *Client) {
+		if timeout <= 0 {
+			timeout = defaultBulkWebhookTimeout
+		}
+		c.bulkWebhook = &bulkWebhookConfig{
+			hook:        hook,
+			callbackURL: callbackURL,
+			timeout:     timeout,
+		}
+	}
+}
+
+type bulkWebhookConfig struct {
+	hook        *BulkFinishWebhook
+	callbackURL string
+	timeout     time.Duration
+}
+
+func (h *BulkFinishWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if !h.verify(body, r.Header.Get("X-Shopify-Hmac-Sha256")) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	if topic := r.Header.Get("X-Shopify-Topic"); topic != "" && topic != bulkOperationsFinishTopic {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	var payload bulkFinishPayload
+	if err := json.Unmarshal(body, &payload); err != nil || payload.AdminGraphqlAPIID == "" {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	h.finish(payload.AdminGraphqlAPIID)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *BulkFinishWebhook) verify(body []byte, signature string) bool {
+	if h.secret == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(h.secret))
+	mac.Write(body)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// finish resumes the waiters of the operation, or keeps the notification for the operation not waited for yet.
+func (h *BulkFinishWebhook) finish(id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	for finishedID, f := range h.finishes {
+		if f.finished && now.Sub(f.receivedAt) > bulkWebhookRetention {
+			delete(h.finishes, finishedID)
+		}
+	}
+
+	f := h.get(id)
+	if f.finished {
+		return
+	}
+	f.finished = true
+	f.receivedAt = now
+	close(f.done)
+}
+
+// wait returns the channel closed when the operation is finished and the function to call once it's no longer waited for.
+func (h *BulkFinishWebhook) wait(id string) (<-chan struct{}, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	f := h.get(id)
+	return f.done, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.finishes, id)
+	}
+}
+
+func (h *BulkFinishWebhook) get(id string) *bulkFinish {
+	f, ok := h.finishes[id]
+	if !ok {
+		f = &bulkFinish{done: make(chan struct{})}
+		h.finishes[id] = f
+	}
+	return f
+}
+
+type mutationWebhookSubscriptionCreate struct {
+	WebhookSubscriptionCreateResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"webhookSubscriptionCreate(topic: $topic, webhookSubscription: $webhookSubscription)" json:"webhookSubscriptionCreate"`
+}
+
+// ensureFinishWebhookSubscription subscribes the callback URL to the `BULK_OPERATIONS_FINISH` topic unless it's already subscribed.
+func (s *BulkOperationServiceOp) ensureFinishWebhookSubscription(ctx context.Context) error {
+	cfg := s.client.bulkWebhook
+	if cfg == nil || cfg.callbackURL == "" || cfg.hook == nil || cfg.hook.secret == "" {
+		return nil
+	}
+
+	if s.isSubscribed() {
+		return nil
+	}
+
+	// Only the callers subscribing wait for each other, not the ones holding s.mu for the slots.
+	s.subscribeMu.Lock()
+	defer s.subscribeMu.Unlock()
+	if s.isSubscribed() {
+		return nil
+	}
+
+	q := `query webhookSubscriptions($callbackUrl: URL!) {
+		webhookSubscriptions(first: 1, topics: [BULK_OPERATIONS_FINISH], callbackUrl: $callbackUrl){
+			edges {
+				node {
+					id
+				}
+			}
+		}
+	}`
+	vars := map[string]interface{}{
+		"callbackUrl": cfg.callbackURL,
+	}
+	var out struct {
+		WebhookSubscriptions struct {
+			Edges []struct {
+				Node struct {
+					ID string `json:"id"`
+				} `json:"node"`
+			} `json:"edges"`
+		} `json:"webhookSubscriptions"`
+	}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return fmt.Errorf("query: %w", err)
+	}
+
+	if len(out.WebhookSubscriptions.Edges) == 0 {
+		m := mutationWebhookSubscriptionCreate{}
+		format := model.WebhookSubscriptionFormatJSON
+		vars := map[string]interface{}{
+			"topic": model.WebhookSubscriptionTopicBulkOperationsFinish,
+			"webhookSubscription": model.WebhookSubscriptionInput{
+				CallbackURL: &cfg.callbackURL,
+				Format:      &format,
+			},
+		}
+		err := s.client.gql.Mutate(ctx, &m, vars)
+		if err != nil {
+			return fmt.Errorf("mutation: %w", err)
+		}
+		if err := newUserErrorsError(m.WebhookSubscriptionCreateResult.UserErrors); err != nil {
+			return err
+		}
+		s.client.log(ctx, slog.LevelDebug, "Subscribed to the bulk operations finish webhook", "callback_url", cfg.callbackURL)
+	}
+
+	s.mu.Lock()
+	s.subscribed = true
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *BulkOperationServiceOp) isSubscribed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.subscribed
+}
+
+// finishWebhookEnabled reports whether the bulk operations are waited for via the webhook handler,
+// which can't verify the webhooks without the secret.
+func (s *BulkOperationServiceOp) finishWebhookEnabled() bool {
+	cfg := s.client.bulkWebhook
+	if cfg == nil || cfg.hook == nil || cfg.hook.secret == "" {
+		return false
+	}
+	if cfg.callbackURL == "" {
+		return true
+	}
+
+	return s.isSubscribed()
+}
+
+// waitForBulkOperationWebhook waits for the webhook of the bulk operation and returns the finished operation,
+// polling it if the webhook doesn't arrive in time.
+func (s *BulkOperationServiceOp) waitForBulkOperationWebhook(ctx context.Context, id string) (*model.BulkOperation, error) {
+	cfg := s.client.bulkWebhook
+
+	done, forget := cfg.hook.wait(id)
+	defer forget()
+
+	timer := time.NewTimer(cfg.timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		s.client.log(ctx, slog.LevelDebug, "Bulk operation finish webhook received", "operation_id", id)
+	case <-timer.C:
+		s.client.log(ctx, slog.LevelDebug, "Bulk operation finish webhook timed out, polling", "operation_id", id)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return s.WaitForBulkOperation(ctx, id, 0)
+}
@@ -0,0 +1,141 @@
+package shopify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postBulkFinishWebhook(h http.Handler, secret string, body string) int {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/bulk", strings.NewReader(body))
+	req.Header.Set("X-Shopify-Topic", bulkOperationsFinishTopic)
+	req.Header.Set("X-Shopify-Hmac-Sha256", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec.Code
+}
+
+func TestBulkFinishWebhook(t *testing.T) {
+	hook := NewBulkFinishWebhook("secret")
+	c, err := NewClientE(WithGraphQLClient(&fakeBulkGraphQL{}), WithLogger(nil), WithBulkFinishWebhook(hook, "", time.Minute))
+	require.NoError(t, err)
+	s := c.BulkOperation.(*BulkOperationServiceOp)
+	require.True(t, s.finishWebhookEnabled())
+
+	id := "gid://shopify/BulkOperation/1"
+	body := `{"admin_graphql_api_id":"` + id + `","completed_at":"2024-01-01T00:00:00-00:00","created_at":"2024-01-01T00:00:00-00:00","error_code":null,"status":"completed","type":"query"}`
+
+	assert.Equal(t, http.StatusUnauthorized, postBulkFinishWebhook(hook, "wrong", body))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		postBulkFinishWebhook(hook, "secret", body)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	q, err := s.waitForBulkOperationWebhook(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, model.BulkOperationStatusCompleted, q.Status)
+	assert.Equal(t, id, q.ID)
+}
+
+func TestBulkFinishWebhookBeforeWait(t *testing.T) {
+	hook := NewBulkFinishWebhook("secret")
+	id := "gid://shopify/BulkOperation/2"
+	assert.Equal(t, http.StatusOK, postBulkFinishWebhook(hook, "secret", `{"admin_graphql_api_id":"`+id+`","status":"completed"}`))
+
+	done, forget := hook.wait(id)
+	defer forget()
+	select {
+	case <-done:
+	default:
+		t.Fatal("the webhook received before waiting is lost")
+	}
+}
+
+func TestBulkFinishWebhookTimeout(t *testing.T) {
+	hook := NewBulkFinishWebhook("secret")
+	c, err := NewClientE(WithGraphQLClient(&fakeBulkGraphQL{}), WithLogger(nil), WithBulkFinishWebhook(hook, "", 10*time.Millisecond))
+	require.NoError(t, err)
+	s := c.BulkOperation.(*BulkOperationServiceOp)
+
+	q, err := s.waitForBulkOperationWebhook(context.Background(), "gid://shopify/BulkOperation/3")
+	require.NoError(t, err)
+	assert.Equal(t, model.BulkOperationStatusCompleted, q.Status)
+}
+
+func TestBulkFinishWebhookWithoutSecret(t *testing.T) {
+	hook := NewBulkFinishWebhook("")
+	assert.Equal(t, http.StatusUnauthorized, postBulkFinishWebhook(hook, "", `{"admin_graphql_api_id":"gid://shopify/BulkOperation/4","status":"completed"}`))
+
+	c, err := NewClientE(WithGraphQLClient(&fakeBulkGraphQL{}), WithLogger(nil), WithBulkFinishWebhook(hook, "", time.Minute))
+	require.NoError(t, err)
+	assert.False(t, c.BulkOperation.(*BulkOperationServiceOp).finishWebhookEnabled(), "the operations are polled")
+}
+
+// blockingSubscriptionGraphQL holds the webhook subscriptions query until released and counts the subscriptions created.
+type blockingSubscriptionGraphQL struct {
+	fakeBulkGraphQL
+	queried chan struct{}
+	release chan struct{}
+	created atomic.Int32
+}
+
+func (f *blockingSubscriptionGraphQL) QueryString(ctx context.Context, q string, variables map[string]interface{}, v interface{}) error {
+	f.queried <- struct{}{}
+	<-f.release
+	return json.Unmarshal([]byte(`{"webhookSubscriptions":{"edges":[]}}`), v)
+}
+
+func (f *blockingSubscriptionGraphQL) Mutate(ctx context.Context, m interface{}, variables map[string]interface{}) error {
+	f.created.Add(1)
+	return nil
+}
+
+func TestEnsureFinishWebhookSubscriptionOutsideLock(t *testing.T) {
+	gql := &blockingSubscriptionGraphQL{queried: make(chan struct{}, 2), release: make(chan struct{})}
+	c, err := NewClientE(WithGraphQLClient(gql), WithLogger(nil), WithBulkFinishWebhook(NewBulkFinishWebhook("secret"), "https://example.com/webhooks/bulk", time.Minute))
+	require.NoError(t, err)
+	s := c.BulkOperation.(*BulkOperationServiceOp)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, s.ensureFinishWebhookSubscription(context.Background()))
+		}()
+	}
+	<-gql.queried
+
+	// The slots are acquired while the subscription is in flight.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	release, err := s.acquireSlot(ctx, model.BulkOperationTypeQuery)
+	require.NoError(t, err)
+	release()
+	assert.False(t, s.finishWebhookEnabled())
+
+	close(gql.release)
+	wg.Wait()
+	assert.Equal(t, int32(1), gql.created.Load(), "subscribed once")
+	assert.True(t, s.finishWebhookEnabled())
+}
@@ -1,28 +1,67 @@
 package shopify
 
 import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
 	"os"
+	"regexp"
 
 	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
+	"github.com/r0busta/go-shopify-graphql/v9/utils"
 	"github.com/r0busta/graphql"
-	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	defaultShopifyAPIVersion = "2025-01"
 )
 
+var (
+	ErrMissingGraphQLClient = errors.New("GraphQL client not set")
+	ErrMissingStoreName     = errors.New("store name not set")
+	ErrMissingAccessToken   = errors.New("access token not set")
+	ErrMissingAPIKey        = errors.New("API key not set")
+	ErrInvalidAPIVersion    = errors.New("invalid API version")
+)
+
+var apiVersionRegex = regexp.MustCompile(`^(\d{4}-\d{2}|unstable)$`)
+
 type Client struct {
-	gql graphql.GraphQL
+	gql        graphql.GraphQL
+	gqlOpts    []graphqlclient.Option
+	httpClient *http.Client
+	transport  http.RoundTripper
+	logger     Logger
+	shopName   string
+	apiVersion string
+
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	telemetry      *telemetry
+
+	bulkPollPolicy          PollPolicy
+	bulkConcurrency         int
+	bulkCancelOnContextDone bool
+	bulkWebhook             *bulkWebhookConfig
+	bulkDownloader          *utils.Downloader
+	bulkStreamingDownload   bool
 
-	Product       ProductService
-	Inventory     InventoryService
-	Collection    CollectionService
-	Order         OrderService
-	Fulfillment   FulfillmentService
-	Location      LocationService
-	Metafield     MetafieldService
-	BulkOperation BulkOperationService
+	Product          ProductService
+	Inventory        InventoryService
+	Collection       CollectionService
+	Order            OrderService
+	Customer         CustomerService
+	DraftOrder       DraftOrderService
+	Refund           RefundService
+	Return           ReturnService
+	Fulfillment      FulfillmentService
+	FulfillmentOrder FulfillmentOrderService
+	Location         LocationService
+	Metafield        MetafieldService
+	BulkOperation    BulkOperationService
 }
 
 type Option func(shopClient *Client)
@@ -33,72 +72,235 @@ func WithGraphQLClient(gql graphql.GraphQL) Option {
 	}
 }
 
-func NewClient(opts ...Option) *Client {
-	c := &Client{}
+// WithGraphQLClientOptions sets the options of the GraphQL client created by the constructors taking credentials,
+// e.g. the rate limiter or the retry policy. It has no effect together with WithGraphQLClient.
+func WithGraphQLClientOptions(opts ...graphqlclient.Option) Option {
+	return func(c *Client) {
+		c.gqlOpts = append(c.gqlOpts, opts...)
+	}
+}
+
+// WithHTTPClient sets the HTTP client of the GraphQL client created by the constructors taking credentials.
+// It is also used for the requests outside the GraphQL API, e.g. the staged uploads.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+		WithGraphQLClientOptions(graphqlclient.WithHTTPClient(httpClient))(c)
+	}
+}
+
+// WithTransport sets the round tripper of the GraphQL client created by the constructors taking credentials.
+// It is also used for the requests outside the GraphQL API, e.g. the staged uploads.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.transport = rt
+		WithGraphQLClientOptions(graphqlclient.WithTransport(rt))(c)
+	}
+}
+
+// WithBaseURL overrides the `https://<shop>.myshopify.com` part of the API endpoint, e.g. to use a fake server in tests.
+func WithBaseURL(baseURL string) Option {
+	return WithGraphQLClientOptions(graphqlclient.WithBaseURL(baseURL))
+}
+
+// Config holds the credentials and settings of a client for a single store.
+type Config struct {
+	StoreName string
+	// APIKey is only set for the private apps using the basic auth.
+	APIKey      string
+	AccessToken string
+	// APIVersion defaults to the version this library is built against.
+	APIVersion string
+}
+
+// Validate returns all the problems found in the config joined into one error.
+func (cfg Config) Validate() error {
+	var errs []error
+	if cfg.StoreName == "" {
+		errs = append(errs, ErrMissingStoreName)
+	}
+	if cfg.AccessToken == "" {
+		errs = append(errs, ErrMissingAccessToken)
+	}
+	if cfg.APIVersion != "" && !apiVersionRegex.MatchString(cfg.APIVersion) {
+		errs = append(errs, ErrInvalidAPIVersion)
+	}
+
+	return errors.Join(errs...)
+}
+
+// NewClientE creates a client with the passed options, returning an error if no GraphQL client is set.
+func NewClientE(opts ...Option) (*Client, error) {
+	return newClient(nil, opts...)
+}
+
+// NewClientFromConfig validates the config and creates a client for the configured store.
+func NewClientFromConfig(cfg Config, opts ...Option) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return newClient(&cfg, opts...)
+}
+
+func newClient(cfg *Config, opts ...Option) (*Client, error) {
+	c := &Client{
+		logger:         slog.Default(),
+		bulkPollPolicy: defaultPollPolicy,
+		bulkDownloader: &utils.Downloader{},
+	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	if c.bulkDownloader.HTTPClient == nil {
+		d := *c.bulkDownloader
+		d.HTTPClient = c.externalHTTPClient()
+		c.bulkDownloader = &d
+	}
+
+	if cfg != nil {
+		c.shopName = cfg.StoreName
+		c.apiVersion = cfg.APIVersion
+		if c.apiVersion == "" {
+			c.apiVersion = defaultShopifyAPIVersion
+		}
+		if c.gql == nil {
+			c.gql = newShopifyGraphQLClient(*cfg, c.gqlOpts...)
+		}
+	}
+
 	if c.gql == nil {
-		log.Fatalln("GraphQL client not set")
+		return nil, ErrMissingGraphQLClient
+	}
+
+	var err error
+	c.telemetry, err = newTelemetry(c.tracerProvider, c.meterProvider)
+	if err != nil {
+		return nil, fmt.Errorf("telemetry: %w", err)
 	}
 
+	c.gql = &gqlClient{gql: c.gql, client: c}
+
 	c.Product = &ProductServiceOp{client: c}
 	c.Inventory = &InventoryServiceOp{client: c}
 	c.Collection = &CollectionServiceOp{client: c}
 	c.Order = &OrderServiceOp{client: c}
+	c.Customer = &CustomerServiceOp{client: c}
+	c.DraftOrder = &DraftOrderServiceOp{client: c}
+	c.Refund = &RefundServiceOp{client: c}
+	c.Return = &ReturnServiceOp{client: c}
 	c.Fulfillment = &FulfillmentServiceOp{client: c}
+	c.FulfillmentOrder = &FulfillmentOrderServiceOp{client: c}
 	c.Location = &LocationServiceOp{client: c}
 	c.Metafield = &MetafieldServiceOp{client: c}
 	c.BulkOperation = &BulkOperationServiceOp{client: c}
 
-	return c
+	return c, nil
+}
+
+// NewDefaultClientE creates a private app client configured with the STORE_API_KEY, STORE_PASSWORD and STORE_NAME environment variables.
+func NewDefaultClientE(opts ...Option) (*Client, error) {
+	cfg := Config{
+		StoreName:   os.Getenv("STORE_NAME"),
+		APIKey:      os.Getenv("STORE_API_KEY"),
+		AccessToken: os.Getenv("STORE_PASSWORD"),
+	}
+	if cfg.APIKey == "" {
+		return nil, errors.Join(ErrMissingAPIKey, cfg.Validate())
+	}
+
+	return NewClientFromConfig(cfg, opts...)
 }
 
-func NewDefaultClient() *Client {
-	apiKey := os.Getenv("STORE_API_KEY")
-	accessToken := os.Getenv("STORE_PASSWORD")
-	storeName := os.Getenv("STORE_NAME")
-	if apiKey == "" || accessToken == "" || storeName == "" {
-		log.Fatalln("Shopify API key and/or password (aka access token) and/or store name not set")
+// NewClientWithTokenE creates a client authenticated with the access token.
+func NewClientWithTokenE(accessToken string, storeName string, opts ...Option) (*Client, error) {
+	cfg := Config{
+		StoreName:   storeName,
+		AccessToken: accessToken,
 	}
 
-	gql := newShopifyGraphQLClientWithBasicAuth(apiKey, accessToken, storeName)
+	return NewClientFromConfig(cfg, opts...)
+}
+
+// NewPrivateClientE creates a client configured with the STORE_PASSWORD access token and the STORE_NAME environment variables.
+func NewPrivateClientE(opts ...Option) (*Client, error) {
+	return NewClientWithTokenE(os.Getenv("STORE_PASSWORD"), os.Getenv("STORE_NAME"), opts...)
+}
+
+// NewClient is like NewClientE but panics if no GraphQL client is set.
+//
+// Deprecated: Use NewClientE, which returns the error instead of crashing the host process.
+func NewClient(opts ...Option) *Client {
+	return mustClient(NewClientE(opts...))
+}
 
-	return NewClient(WithGraphQLClient(gql))
+// NewDefaultClient is like NewDefaultClientE but panics if the environment variables are not set.
+//
+// Deprecated: Use NewDefaultClientE, which returns the error instead of crashing the host process.
+func NewDefaultClient(opts ...Option) *Client {
+	return mustClient(NewDefaultClientE(opts...))
 }
 
-func NewPrivateClient() *Client {
-	return NewClientWithToken(os.Getenv("STORE_PASSWORD"), os.Getenv("STORE_NAME"))
+// NewPrivateClient is like NewPrivateClientE but panics if the environment variables are not set.
+//
+// Deprecated: Use NewPrivateClientE, which returns the error instead of crashing the host process.
+func NewPrivateClient(opts ...Option) *Client {
+	return mustClient(NewPrivateClientE(opts...))
 }
 
+// NewClientWithToken is like NewClientWithTokenE but panics if the access token or store name is not set.
+//
+// Deprecated: Use NewClientWithTokenE, which returns the error instead of crashing the host process.
 func NewClientWithToken(accessToken string, storeName string, opts ...Option) *Client {
-	if accessToken == "" || storeName == "" {
-		log.Fatalln("Shopify API access token and/or store name not set")
-	}
+	return mustClient(NewClientWithTokenE(accessToken, storeName, opts...))
+}
 
-	gql := newShopifyGraphQLClientWithToken(accessToken, storeName)
+// mustClient panics with the construction error, if any, for the deprecated constructors without the E suffix.
+func mustClient(c *Client, err error) *Client {
+	if err != nil {
+		panic(err)
+	}
 
-	return NewClient(WithGraphQLClient(gql))
+	return c
 }
 
-func newShopifyGraphQLClientWithBasicAuth(apiKey string, accessToken string, storeName string) *graphql.Client {
+func newShopifyGraphQLClient(cfg Config, extraOpts ...graphqlclient.Option) *graphql.Client {
+	apiVersion := cfg.APIVersion
+	if apiVersion == "" {
+		apiVersion = defaultShopifyAPIVersion
+	}
+
 	opts := []graphqlclient.Option{
-		graphqlclient.WithVersion(defaultShopifyAPIVersion),
-		graphqlclient.WithPrivateAppAuth(apiKey, accessToken),
+		graphqlclient.WithVersion(apiVersion),
+	}
+	if cfg.APIKey != "" {
+		opts = append(opts, graphqlclient.WithPrivateAppAuth(cfg.APIKey, cfg.AccessToken))
+	} else {
+		opts = append(opts, graphqlclient.WithToken(cfg.AccessToken))
 	}
+	opts = append(opts, extraOpts...)
 
-	return graphqlclient.NewClient(storeName, opts...)
+	return graphqlclient.NewClient(cfg.StoreName, opts...)
 }
 
-func newShopifyGraphQLClientWithToken(accessToken string, storeName string) *graphql.Client {
-	opts := []graphqlclient.Option{
-		graphqlclient.WithVersion(defaultShopifyAPIVersion),
-		graphqlclient.WithToken(accessToken),
+// externalHTTPClient returns the HTTP client for the requests outside the GraphQL API, built from the client and the transport
+// set with WithHTTPClient and WithTransport the same way the GraphQL client is, but without the Shopify authentication.
+func (c *Client) externalHTTPClient() *http.Client {
+	if c.httpClient == nil && c.transport == nil {
+		return http.DefaultClient
+	}
+
+	httpClient := &http.Client{}
+	if c.httpClient != nil {
+		*httpClient = *c.httpClient
+	}
+	if c.transport != nil {
+		httpClient.Transport = c.transport
 	}
 
-	return graphqlclient.NewClient(storeName, opts...)
+	return httpClient
 }
 
 func (c *Client) GraphQLClient() graphql.GraphQL {
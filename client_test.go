@@ -0,0 +1,92 @@
+package shopify_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/r0busta/go-shopify-graphql/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      shopify.Config
+		expected []error
+	}{{
+		name: "valid",
+		cfg:  shopify.Config{StoreName: "store", AccessToken: "token", APIVersion: "2025-01"},
+	}, {
+		name: "unstable version",
+		cfg:  shopify.Config{StoreName: "store", AccessToken: "token", APIVersion: "unstable"},
+	}, {
+		name:     "missing credentials",
+		cfg:      shopify.Config{},
+		expected: []error{shopify.ErrMissingStoreName, shopify.ErrMissingAccessToken},
+	}, {
+		name:     "invalid version",
+		cfg:      shopify.Config{StoreName: "store", AccessToken: "token", APIVersion: "2025-1"},
+		expected: []error{shopify.ErrInvalidAPIVersion},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if len(tt.expected) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, e := range tt.expected {
+				assert.ErrorIs(t, err, e)
+			}
+		})
+	}
+}
+
+func TestNewClientE(t *testing.T) {
+	_, err := shopify.NewClientE()
+	assert.ErrorIs(t, err, shopify.ErrMissingGraphQLClient)
+
+	_, err = shopify.NewClientWithTokenE("", "store")
+	assert.ErrorIs(t, err, shopify.ErrMissingAccessToken)
+
+	c, err := shopify.NewClientFromConfig(shopify.Config{StoreName: "store", AccessToken: "token"})
+	require.NoError(t, err)
+	assert.NotNil(t, c.GraphQLClient())
+
+	t.Setenv("STORE_NAME", "store")
+	t.Setenv("STORE_PASSWORD", "")
+	_, err = shopify.NewPrivateClientE()
+	assert.ErrorIs(t, err, shopify.ErrMissingAccessToken)
+	assert.Panics(t, func() { shopify.NewPrivateClient() })
+}
+
+type countingTransport struct {
+	calls int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewClientWithTokenOptions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/admin/api/2025-01/graphql.json", r.URL.Path)
+		assert.Equal(t, "token", r.Header.Get("X-Shopify-Access-Token"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"location":{"id":"gid://shopify/Location/1","name":"Warehouse"}}}`))
+	}))
+	defer srv.Close()
+
+	rt := &countingTransport{}
+	c, err := shopify.NewClientWithTokenE("token", "store", shopify.WithBaseURL(srv.URL), shopify.WithHTTPClient(&http.Client{Transport: rt}))
+	require.NoError(t, err)
+
+	l, err := c.Location.Get(context.Background(), "gid://shopify/Location/1")
+	require.NoError(t, err)
+	assert.Equal(t, "Warehouse", l.Name)
+	assert.Equal(t, 1, rt.calls)
+}
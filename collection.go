@@ -3,16 +3,17 @@ package shopify
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
-	log "github.com/sirupsen/logrus"
 )
 
 //go:generate mockgen -destination=./mock/collection_service.go -package=mock . CollectionService
 type CollectionService interface {
-	ListAll(ctx context.Context) ([]model.Collection, error)
+	ListAll(ctx context.Context, fields ...FieldSelector) ([]model.Collection, error)
+	Paginate(opts ListOptions, fields ...FieldSelector) *CollectionPaginator
 
-	Get(ctx context.Context, id string) (*model.Collection, error)
+	Get(ctx context.Context, id string, fields ...FieldSelector) (*model.Collection, error)
 
 	Create(ctx context.Context, collection model.CollectionInput) (*string, error)
 	CreateBulk(ctx context.Context, collections []model.CollectionInput) error
@@ -26,6 +27,11 @@ type CollectionServiceOp struct {
 
 var _ CollectionService = &CollectionServiceOp{}
 
+// CollectionPaginator pages through the collections.
+type CollectionPaginator struct {
+	*Paginator[model.Collection]
+}
+
 type mutationCollectionCreate struct {
 	CollectionCreateResult struct {
 		Collection *struct {
@@ -47,7 +53,7 @@ var collectionQuery = `
 	handle	
 	title
 
-	products(first:250, after: $cursor){
+	products(first: $first, after: $after){
 		edges{
 			node{
 				id
@@ -56,6 +62,7 @@ var collectionQuery = `
 		}
 		pageInfo{
 			hasNextPage
+			endCursor
 		}		
 	}	
 `
@@ -66,7 +73,12 @@ var collectionBulkQuery = `
 	title
 `
 
-func (s *CollectionServiceOp) ListAll(ctx context.Context) ([]model.Collection, error) {
+func (s *CollectionServiceOp) ListAll(ctx context.Context, fields ...FieldSelector) ([]model.Collection, error) {
+	sel, err := selectFields(fields, "", true, selectionSet{fields: collectionBulkQuery})
+	if err != nil {
+		return nil, err
+	}
+
 	q := fmt.Sprintf(`
 		{
 			collections{
@@ -77,10 +89,12 @@ func (s *CollectionServiceOp) ListAll(ctx context.Context) ([]model.Collection,
 				}
 			}
 		}
-	`, collectionBulkQuery)
+
+		%s
+	`, sel.fields, sel.fragments)
 
 	res := []model.Collection{}
-	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	err = s.client.BulkOperation.BulkQuery(ctx, q, &res)
 	if err != nil {
 		return nil, fmt.Errorf("bulk query: %w", err)
 	}
@@ -88,59 +102,101 @@ func (s *CollectionServiceOp) ListAll(ctx context.Context) ([]model.Collection,
 	return res, nil
 }
 
-func (s *CollectionServiceOp) Get(ctx context.Context, id string) (*model.Collection, error) {
-	out, err := s.getPage(ctx, id, "")
+func (s *CollectionServiceOp) Get(ctx context.Context, id string, fields ...FieldSelector) (*model.Collection, error) {
+	sel, err := selectFields(fields, "products", false, selectionSet{fields: collectionQuery, paged: true})
 	if err != nil {
 		return nil, err
 	}
 
-	nextPageData := out
-	hasNextPage := out.Products.PageInfo.HasNextPage
-	for hasNextPage && len(nextPageData.Products.Edges) > 0 {
-		cursor := nextPageData.Products.Edges[len(nextPageData.Products.Edges)-1].Cursor
-		nextPageData, err := s.getPage(ctx, id, cursor)
+	q := fmt.Sprintf(`
+		query collection($id: ID!%s) {
+			collection(id: $id){
+				%s
+			}
+		}
+
+		%s
+	`, sel.pageVariables(), sel.fields, sel.fragments)
+
+	if !sel.paged {
+		out := struct {
+			Collection *model.Collection `json:"collection"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, map[string]interface{}{"id": id}, &out)
+		if err != nil {
+			return nil, fmt.Errorf("query: %w", err)
+		}
+		if out.Collection == nil {
+			return nil, fmt.Errorf("collection %s: %w", id, ErrNotFound)
+		}
+		return out.Collection, nil
+	}
+
+	var collection *model.Collection
+	products := NewPaginator(ListOptions{First: maxPageSize}, func(ctx context.Context, args PageArgs) (*Connection[*model.Product], error) {
+		page, err := s.getPage(ctx, q, id, args)
+		if err != nil {
+			return nil, err
+		}
+		if collection == nil {
+			collection = page.Collection
+		}
+		return &page.Products, nil
+	})
+
+	edges := []model.ProductEdge{}
+	for products.HasNext() {
+		page, err := products.NextPage(ctx)
 		if err != nil {
 			return nil, err
 		}
-		out.Products.Edges = append(out.Products.Edges, nextPageData.Products.Edges...)
-		hasNextPage = nextPageData.Products.PageInfo.HasNextPage
+		for _, e := range page.Edges {
+			edges = append(edges, model.ProductEdge{Cursor: e.Cursor, Node: e.Node})
+		}
+	}
+	collection.Products = &model.ProductConnection{
+		Edges:    edges,
+		PageInfo: &model.PageInfo{},
 	}
 
-	return out, nil
+	return collection, nil
 }
 
-func (s *CollectionServiceOp) getPage(ctx context.Context, id string, cursor string) (*model.Collection, error) {
-	q := fmt.Sprintf(`
-		query collection($id: ID!, $cursor: String) {
-			collection(id: $id){
-				%s
-			}
-		}
-	`, collectionQuery)
+// collectionPage is a collection along with a page of its products.
+type collectionPage struct {
+	*model.Collection
+	Products Connection[*model.Product] `json:"products"`
+}
 
-	vars := map[string]interface{}{
+func (s *CollectionServiceOp) getPage(ctx context.Context, q string, id string, args PageArgs) (*collectionPage, error) {
+	vars := args.Variables(map[string]interface{}{
 		"id": id,
-	}
-	if cursor != "" {
-		vars["cursor"] = cursor
-	}
+	})
 
 	out := struct {
-		Collection *model.Collection `json:"collection"`
+		Collection *collectionPage `json:"collection"`
 	}{}
 	err := s.client.gql.QueryString(ctx, q, vars, &out)
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
 
+	if out.Collection == nil || out.Collection.Collection == nil {
+		return nil, fmt.Errorf("collection %s: %w", id, ErrNotFound)
+	}
+
 	return out.Collection, nil
 }
 
+func (s *CollectionServiceOp) Paginate(opts ListOptions, fields ...FieldSelector) *CollectionPaginator {
+	return &CollectionPaginator{NewPaginator(opts, listConnection[model.Collection](s.client, "collections", opts, fields, selectionSet{fields: collectionBulkQuery}))}
+}
+
 func (s *CollectionServiceOp) CreateBulk(ctx context.Context, collections []model.CollectionInput) error {
 	for _, c := range collections {
 		_, err := s.client.Collection.Create(ctx, c)
 		if err != nil {
-			log.Warnf("Couldn't create collection (%v): %s", c, err)
+			s.client.log(ctx, slog.LevelWarn, "Couldn't create collection", "input", c, "error", err)
 		}
 	}
 
@@ -158,8 +214,8 @@ func (s *CollectionServiceOp) Create(ctx context.Context, collection model.Colle
 		return nil, fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.CollectionCreateResult.UserErrors) > 0 {
-		return nil, fmt.Errorf("%+v", m.CollectionCreateResult.UserErrors)
+	if err := newUserErrorsError(m.CollectionCreateResult.UserErrors); err != nil {
+		return nil, err
 	}
 
 	return &m.CollectionCreateResult.Collection.ID, nil
@@ -176,8 +232,8 @@ func (s *CollectionServiceOp) Update(ctx context.Context, collection model.Colle
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.CollectionCreateResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.CollectionCreateResult.UserErrors)
+	if err := newUserErrorsError(m.CollectionCreateResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -0,0 +1,458 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+)
+
+//go:generate mockgen -destination=./mock/customer_service.go -package=mock . CustomerService
+type CustomerService interface {
+	Get(ctx context.Context, id string, fields ...FieldSelector) (*model.Customer, error)
+	GetByEmail(ctx context.Context, email string, fields ...FieldSelector) (*model.Customer, error)
+
+	List(ctx context.Context, query string, fields ...FieldSelector) ([]model.Customer, error)
+	ListAll(ctx context.Context, fields ...FieldSelector) ([]model.Customer, error)
+	Paginate(opts ListOptions, fields ...FieldSelector) *CustomerPaginator
+
+	Create(ctx context.Context, customer model.CustomerInput) (*string, error)
+	Update(ctx context.Context, customer model.CustomerInput) error
+	Delete(ctx context.Context, id string) error
+
+	UpdateAddresses(ctx context.Context, id string, addresses []model.MailingAddressInput) error
+	SetDefaultAddress(ctx context.Context, id string, addressID string) error
+
+	UpdateEmailMarketingConsent(ctx context.Context, input model.CustomerEmailMarketingConsentUpdateInput) error
+
+	Merge(ctx context.Context, customerOneID string, customerTwoID string, overrideFields *model.CustomerMergeOverrideFields) (*string, error)
+
+	AddTags(ctx context.Context, id string, tags []string) error
+	RemoveTags(ctx context.Context, id string, tags []string) error
+}
+
+type CustomerServiceOp struct {
+	client *Client
+}
+
+var _ CustomerService = &CustomerServiceOp{}
+
+// CustomerPaginator pages through the customers.
+type CustomerPaginator struct {
+	*Paginator[model.Customer]
+}
+
+type mutationCustomerCreate struct {
+	CustomerCreateResult struct {
+		Customer *struct {
+			ID string `json:"id,omitempty"`
+		} `json:"customer,omitempty"`
+
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"customerCreate(input: $input)" json:"customerCreate"`
+}
+
+type mutationCustomerUpdate struct {
+	CustomerUpdateResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"customerUpdate(input: $input)" json:"customerUpdate"`
+}
+
+type mutationCustomerDelete struct {
+	CustomerDeleteResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"customerDelete(input: $input)" json:"customerDelete"`
+}
+
+type mutationCustomerUpdateDefaultAddress struct {
+	CustomerUpdateDefaultAddressResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"customerUpdateDefaultAddress(customerId: $customerId, addressId: $addressId)" json:"customerUpdateDefaultAddress"`
+}
+
+type mutationCustomerEmailMarketingConsentUpdate struct {
+	CustomerEmailMarketingConsentUpdateResult struct {
+		UserErrors []model.CustomerEmailMarketingConsentUpdateUserError `json:"userErrors,omitempty"`
+	} `graphql:"customerEmailMarketingConsentUpdate(input: $input)" json:"customerEmailMarketingConsentUpdate"`
+}
+
+type mutationCustomerMerge struct {
+	CustomerMergeResult struct {
+		ResultingCustomerID *string `json:"resultingCustomerId,omitempty"`
+
+		UserErrors []model.CustomerMergeUserError `json:"userErrors,omitempty"`
+	} `graphql:"customerMerge(customerOneId: $customerOneId, customerTwoId: $customerTwoId, overrideFields: $overrideFields)" json:"customerMerge"`
+}
+
+const customerBaseQuery = `
+	id
+	legacyResourceId
+	firstName
+	lastName
+	displayName
+	email
+	phone
+	note
+	tags
+	locale
+	state
+	verifiedEmail
+	taxExempt
+	numberOfOrders
+	createdAt
+	updatedAt
+	amountSpent{
+		amount
+		currencyCode
+	}
+	emailMarketingConsent{
+		marketingState
+		marketingOptInLevel
+		consentUpdatedAt
+	}
+	defaultAddress{
+		id
+	}
+	addresses{
+		id
+		firstName
+		lastName
+		company
+		address1
+		address2
+		city
+		province
+		provinceCode
+		country
+		countryCodeV2
+		zip
+		phone
+	}
+`
+
+var customerQuery = fmt.Sprintf(`
+	%s
+	metafields(first:50){
+		edges{
+			node{
+				id
+				legacyResourceId
+				namespace
+				key
+				value
+				type
+			}
+		}
+	}
+`, customerBaseQuery)
+
+var customerBulkQuery = fmt.Sprintf(`
+	%s
+	metafields{
+		edges{
+			node{
+				id
+				legacyResourceId
+				namespace
+				key
+				value
+				type
+			}
+		}
+	}
+`, customerBaseQuery)
+
+func (s *CustomerServiceOp) Get(ctx context.Context, id string, fields ...FieldSelector) (*model.Customer, error) {
+	sel, err := selectFields(fields, "", false, selectionSet{fields: customerQuery})
+	if err != nil {
+		return nil, err
+	}
+
+	q := fmt.Sprintf(`
+		query customer($id: ID!) {
+			customer(id: $id){
+				%s
+			}
+		}
+
+		%s
+	`, sel.fields, sel.fragments)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	out := struct {
+		Customer *model.Customer `json:"customer"`
+	}{}
+	err = s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	if out.Customer == nil {
+		return nil, fmt.Errorf("customer %s: %w", id, ErrNotFound)
+	}
+
+	return out.Customer, nil
+}
+
+// GetByEmail returns the customer with the email, with the same fields selected by default as Get.
+func (s *CustomerServiceOp) GetByEmail(ctx context.Context, email string, fields ...FieldSelector) (*model.Customer, error) {
+	sel, err := selectFields(fields, "", false, selectionSet{fields: customerQuery})
+	if err != nil {
+		return nil, err
+	}
+
+	q := fmt.Sprintf(`
+		query customerByEmail($query: String!) {
+			customers(first: 1, query: $query){
+				edges{
+					node{
+						%s
+					}
+				}
+			}
+		}
+
+		%s
+	`, sel.fields, sel.fragments)
+
+	vars := map[string]interface{}{
+		"query": Search().Field("email", email).String(),
+	}
+
+	out := struct {
+		Customers Connection[model.Customer] `json:"customers"`
+	}{}
+	err = s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	if len(out.Customers.Edges) == 0 {
+		return nil, fmt.Errorf("customer %s: %w", email, ErrNotFound)
+	}
+
+	return &out.Customers.Edges[0].Node, nil
+}
+
+func (s *CustomerServiceOp) List(ctx context.Context, query string, fields ...FieldSelector) ([]model.Customer, error) {
+	sel, err := selectFields(fields, "", true, selectionSet{fields: customerBulkQuery})
+	if err != nil {
+		return nil, err
+	}
+
+	q := fmt.Sprintf(`
+		{
+			customers(query: %s){
+				edges{
+					node{
+						%s
+					}
+				}
+			}
+		}
+
+		%s
+	`, graphQLString(query), sel.fields, sel.fragments)
+
+	res := []model.Customer{}
+	err = s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	if err != nil {
+		return nil, fmt.Errorf("bulk query: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *CustomerServiceOp) ListAll(ctx context.Context, fields ...FieldSelector) ([]model.Customer, error) {
+	sel, err := selectFields(fields, "", true, selectionSet{fields: customerBulkQuery})
+	if err != nil {
+		return nil, err
+	}
+
+	q := fmt.Sprintf(`
+		{
+			customers{
+				edges{
+					node{
+						%s
+					}
+				}
+			}
+		}
+
+		%s
+	`, sel.fields, sel.fragments)
+
+	res := []model.Customer{}
+	err = s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	if err != nil {
+		return nil, fmt.Errorf("bulk query: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *CustomerServiceOp) Paginate(opts ListOptions, fields ...FieldSelector) *CustomerPaginator {
+	return &CustomerPaginator{NewPaginator(opts, listConnection[model.Customer](s.client, "customers", opts, fields, selectionSet{fields: customerBaseQuery}))}
+}
+
+func (s *CustomerServiceOp) Create(ctx context.Context, customer model.CustomerInput) (*string, error) {
+	m := mutationCustomerCreate{}
+
+	vars := map[string]interface{}{
+		"input": customer,
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.CustomerCreateResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return &m.CustomerCreateResult.Customer.ID, nil
+}
+
+func (s *CustomerServiceOp) Update(ctx context.Context, customer model.CustomerInput) error {
+	m := mutationCustomerUpdate{}
+
+	vars := map[string]interface{}{
+		"input": customer,
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.CustomerUpdateResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *CustomerServiceOp) Delete(ctx context.Context, id string) error {
+	m := mutationCustomerDelete{}
+
+	vars := map[string]interface{}{
+		"input": model.CustomerDeleteInput{ID: id},
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.CustomerDeleteResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateAddresses replaces the addresses of the customer. No addresses clear them.
+func (s *CustomerServiceOp) UpdateAddresses(ctx context.Context, id string, addresses []model.MailingAddressInput) error {
+	m := `
+		mutation customerUpdateAddresses($input: CustomerInput!) {
+			customerUpdate(input: $input){
+				userErrors{
+					field
+					message
+				}
+			}
+		}
+	`
+
+	if addresses == nil {
+		addresses = []model.MailingAddressInput{}
+	}
+
+	// CustomerInput omits the empty addresses, which wouldn't clear them
+	vars := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id":        id,
+			"addresses": addresses,
+		},
+	}
+
+	out := mutationCustomerUpdate{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.CustomerUpdateResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *CustomerServiceOp) SetDefaultAddress(ctx context.Context, id string, addressID string) error {
+	m := mutationCustomerUpdateDefaultAddress{}
+
+	vars := map[string]interface{}{
+		"customerId": id,
+		"addressId":  addressID,
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.CustomerUpdateDefaultAddressResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *CustomerServiceOp) UpdateEmailMarketingConsent(ctx context.Context, input model.CustomerEmailMarketingConsentUpdateInput) error {
+	m := mutationCustomerEmailMarketingConsentUpdate{}
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.CustomerEmailMarketingConsentUpdateResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Merge merges the two customers and returns the ID of the resulting customer. The merge itself runs asynchronously.
+func (s *CustomerServiceOp) Merge(ctx context.Context, customerOneID string, customerTwoID string, overrideFields *model.CustomerMergeOverrideFields) (*string, error) {
+	m := mutationCustomerMerge{}
+
+	vars := map[string]interface{}{
+		"customerOneId":  customerOneID,
+		"customerTwoId":  customerTwoID,
+		"overrideFields": overrideFields,
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.CustomerMergeResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return m.CustomerMergeResult.ResultingCustomerID, nil
+}
+
+func (s *CustomerServiceOp) AddTags(ctx context.Context, id string, tags []string) error {
+	return addTags(ctx, s.client, id, tags)
+}
+
+func (s *CustomerServiceOp) RemoveTags(ctx context.Context, id string, tags []string) error {
+	return removeTags(ctx, s.client, id, tags)
+}
@@ -0,0 +1,210 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomerGetByEmail(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"customers":{"edges":[{"node":{"id":"gid://shopify/Customer/1","email":"jane@example.com","metafields":{"edges":[{"node":{"key":"tier","value":"gold"}}]}}}]}}`,
+		`{"customers":{"edges":[]}}`,
+	)
+	c := srv.client(t)
+
+	customer, err := c.Customer.GetByEmail(context.Background(), "jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/Customer/1", customer.ID)
+	require.Len(t, customer.Metafields.Edges, 1)
+	assert.Equal(t, "tier", customer.Metafields.Edges[0].Node.Key)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "query customerByEmail($query: String!)")
+	assert.Contains(t, req.Query, "customers(first: 1, query: $query)")
+	assert.Contains(t, req.Query, "metafields(first:50)", "the metafields are selected like in Get")
+	assert.Equal(t, map[string]interface{}{"query": "email:jane@example.com"}, req.Variables)
+
+	_, err = c.Customer.GetByEmail(context.Background(), "john@example.com")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCustomerDelete(t *testing.T) {
+	srv := newGraphQLServer(t, `{"customerDelete":{"userErrors":[]}}`)
+	c := srv.client(t)
+
+	require.NoError(t, c.Customer.Delete(context.Background(), "gid://shopify/Customer/1"))
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation($input:CustomerDeleteInput!){customerDelete(input: $input)")
+	assert.Equal(t, map[string]interface{}{"input": map[string]interface{}{"id": "gid://shopify/Customer/1"}}, req.Variables)
+}
+
+func TestCustomerMerge(t *testing.T) {
+	srv := newGraphQLServer(t, `{"customerMerge":{"userErrors":[{"field":["customerTwoId"],"message":"Customer has gift cards","code":"CUSTOMER_HAS_GIFT_CARDS"}]}}`)
+	c := srv.client(t)
+
+	_, err := c.Customer.Merge(context.Background(), "gid://shopify/Customer/1", "gid://shopify/Customer/2", &model.CustomerMergeOverrideFields{CustomerIDOfEmailToKeep: ptr("gid://shopify/Customer/2")})
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, string(model.CustomerMergeErrorCodeCustomerHasGiftCards), userErrs.Errors[0].Code)
+	assert.Equal(t, []string{"customerTwoId"}, userErrs.Errors[0].Field)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation($customerOneId:ID!$customerTwoId:ID!$overrideFields:CustomerMergeOverrideFields){customerMerge(customerOneId: $customerOneId, customerTwoId: $customerTwoId, overrideFields: $overrideFields)")
+	assert.Equal(t, map[string]interface{}{
+		"customerOneId":  "gid://shopify/Customer/1",
+		"customerTwoId":  "gid://shopify/Customer/2",
+		"overrideFields": map[string]interface{}{"customerIdOfEmailToKeep": "gid://shopify/Customer/2"},
+	}, req.Variables)
+}
+
+func TestCustomerCreate(t *testing.T) {
+	srv := newGraphQLServer(t, `{"customerCreate":{"customer":{"id":"gid://shopify/Customer/1"},"userErrors":[]}}`)
+	c := srv.client(t)
+
+	email := "jane@example.com"
+	id, err := c.Customer.Create(context.Background(), model.CustomerInput{
+		Email: &email,
+		Tags:  []string{"vip"},
+		Addresses: []model.MailingAddressInput{
+			{City: ptr("Berlin")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/Customer/1", *id)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation($input:CustomerInput!){customerCreate(input: $input)")
+	assert.Equal(t, map[string]interface{}{
+		"email":     "jane@example.com",
+		"tags":      []interface{}{"vip"},
+		"addresses": []interface{}{map[string]interface{}{"city": "Berlin"}},
+	}, req.Variables["input"])
+}
+
+func TestCustomerUpdate(t *testing.T) {
+	srv := newGraphQLServer(t, `{"customerUpdate":{"userErrors":[{"field":["input","email"],"message":"Email has already been taken"}]}}`)
+	c := srv.client(t)
+
+	id, note := "gid://shopify/Customer/1", "Prefers email"
+	err := c.Customer.Update(context.Background(), model.CustomerInput{ID: &id, Note: &note})
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, []string{"input", "email"}, userErrs.Errors[0].Field)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation($input:CustomerInput!){customerUpdate(input: $input)")
+	assert.Equal(t, map[string]interface{}{"id": id, "note": note}, req.Variables["input"])
+}
+
+func TestCustomerAddresses(t *testing.T) {
+	srv := newGraphQLServer(t, `{"customerUpdate":{"userErrors":[]}}`, `{"customerUpdate":{"userErrors":[]}}`, `{"customerUpdateDefaultAddress":{"userErrors":[]}}`)
+	c := srv.client(t)
+
+	require.NoError(t, c.Customer.UpdateAddresses(context.Background(), "gid://shopify/Customer/1", []model.MailingAddressInput{
+		{Address1: ptr("Main St 1"), City: ptr("Berlin")},
+		{Address1: ptr("Side St 2"), City: ptr("Hamburg")},
+	}))
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation customerUpdateAddresses($input: CustomerInput!)")
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"address1": "Main St 1", "city": "Berlin"},
+		map[string]interface{}{"address1": "Side St 2", "city": "Hamburg"},
+	}, req.Variables["input"].(map[string]interface{})["addresses"])
+
+	require.NoError(t, c.Customer.UpdateAddresses(context.Background(), "gid://shopify/Customer/1", nil))
+	req = srv.last(t)
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/Customer/1", "addresses": []interface{}{}}, req.Variables["input"], "the addresses are cleared rather than left out")
+
+	require.NoError(t, c.Customer.SetDefaultAddress(context.Background(), "gid://shopify/Customer/1", "gid://shopify/MailingAddress/2"))
+	req = srv.last(t)
+	assert.Contains(t, req.Query, "mutation($addressId:ID!$customerId:ID!){customerUpdateDefaultAddress(customerId: $customerId, addressId: $addressId)")
+	assert.Equal(t, map[string]interface{}{
+		"customerId": "gid://shopify/Customer/1",
+		"addressId":  "gid://shopify/MailingAddress/2",
+	}, req.Variables)
+}
+
+func TestCustomerUpdateEmailMarketingConsent(t *testing.T) {
+	srv := newGraphQLServer(t, `{"customerEmailMarketingConsentUpdate":{"userErrors":[{"field":["input","emailMarketingConsent"],"message":"Invalid state","code":"INVALID"}]}}`)
+	c := srv.client(t)
+
+	err := c.Customer.UpdateEmailMarketingConsent(context.Background(), model.CustomerEmailMarketingConsentUpdateInput{
+		CustomerID: "gid://shopify/Customer/1",
+		EmailMarketingConsent: &model.CustomerEmailMarketingConsentInput{
+			MarketingState:      model.CustomerEmailMarketingStateSubscribed,
+			MarketingOptInLevel: ptr(model.CustomerMarketingOptInLevelConfirmedOptIn),
+		},
+	})
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, "INVALID", userErrs.Errors[0].Code)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation($input:CustomerEmailMarketingConsentUpdateInput!){customerEmailMarketingConsentUpdate(input: $input)")
+	assert.Equal(t, map[string]interface{}{
+		"customerId": "gid://shopify/Customer/1",
+		"emailMarketingConsent": map[string]interface{}{
+			"marketingState":      "SUBSCRIBED",
+			"marketingOptInLevel": "CONFIRMED_OPT_IN",
+		},
+	}, req.Variables["input"])
+}
+
+func TestCustomerTags(t *testing.T) {
+	srv := newGraphQLServer(t, `{"tagsAdd":{"userErrors":[]}}`, `{"tagsRemove":{"userErrors":[]}}`)
+	c := srv.client(t)
+
+	require.NoError(t, c.Customer.AddTags(context.Background(), "gid://shopify/Customer/1", []string{"vip", "wholesale"}))
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation($id:ID!$tags:[String!]!){tagsAdd(id: $id, tags: $tags)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/Customer/1", "tags": []interface{}{"vip", "wholesale"}}, req.Variables)
+
+	require.NoError(t, c.Customer.RemoveTags(context.Background(), "gid://shopify/Customer/1", []string{"wholesale"}))
+	req = srv.last(t)
+	assert.Contains(t, req.Query, "mutation($id:ID!$tags:[String!]!){tagsRemove(id: $id, tags: $tags)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/Customer/1", "tags": []interface{}{"wholesale"}}, req.Variables)
+}
+
+func TestCustomerPaginate(t *testing.T) {
+	srv := newGraphQLServer(t, `{"customers":{"edges":[{"node":{"id":"gid://shopify/Customer/1"},"cursor":"c1"}],"pageInfo":{"hasNextPage":false}}}`)
+	c := srv.client(t)
+
+	customers, err := c.Customer.Paginate(ListOptions{Query: Search().Field("tag", "vip").String(), First: 10}).Next(context.Background())
+	require.NoError(t, err)
+	require.Len(t, customers, 1)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "customers(query: $query, first: $first")
+	assert.Equal(t, map[string]interface{}{"query": "tag:vip", "first": float64(10), "reverse": false}, req.Variables)
+}
+
+func TestCustomerList(t *testing.T) {
+	srv := newGraphQLServer(t)
+	srv.responses = []string{
+		`{"bulkOperationRunQuery":{"bulkOperation":{"id":"gid://shopify/BulkOperation/1","createdAt":"2024-01-01T10:00:00Z"},"userErrors":[]}}`,
+		fmt.Sprintf(`{"node":{"id":"gid://shopify/BulkOperation/1","status":"COMPLETED","objectCount":"3","url":%q}}`, srv.URL+"/result.jsonl"),
+	}
+	srv.bulkResult = `{"id":"gid://shopify/Customer/1","email":"jane@example.com"}
+{"id":"gid://shopify/Metafield/1","key":"tier","value":"gold","__parentId":"gid://shopify/Customer/1"}
+{"id":"gid://shopify/Customer/2","email":"john@example.com"}
+`
+	c := srv.client(t)
+
+	customers, err := c.Customer.List(context.Background(), Search().Field("tag", "vip").Field("state", "enabled").String())
+	require.NoError(t, err)
+	require.Len(t, customers, 2)
+	assert.Equal(t, "jane@example.com", *customers[0].Email)
+	assert.Equal(t, "john@example.com", *customers[1].Email)
+	require.Len(t, customers[0].Metafields.Edges, 1, "the metafields are attached to their customer")
+	assert.Equal(t, "gold", customers[0].Metafields.Edges[0].Node.Value)
+
+	post := srv.request(t, 0)
+	assert.Contains(t, post.Query, "bulkOperationRunQuery(query: $query)")
+	assert.Contains(t, post.Variables["query"], `customers(query: "tag:vip AND state:enabled")`)
+	assert.Equal(t, "gid://shopify/BulkOperation/1", srv.request(t, 1).Variables["id"])
+}
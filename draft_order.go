@@ -0,0 +1,639 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+)
+
+//go:generate mockgen -destination=./mock/draft_order_service.go -package=mock . DraftOrderService
+type DraftOrderService interface {
+	Get(ctx context.Context, id string, fields ...FieldSelector) (*model.DraftOrder, error)
+
+	List(ctx context.Context, opts ListOptions, fields ...FieldSelector) ([]model.DraftOrder, error)
+	ListAll(ctx context.Context, fields ...FieldSelector) ([]model.DraftOrder, error)
+
+	Create(ctx context.Context, input model.DraftOrderInput) (*model.DraftOrder, error)
+	Update(ctx context.Context, id string, input model.DraftOrderInput) (*model.DraftOrder, error)
+	Calculate(ctx context.Context, input model.DraftOrderInput) (*model.CalculatedDraftOrder, error)
+	Duplicate(ctx context.Context, id string) (*model.DraftOrder, error)
+	Delete(ctx context.Context, id string) error
+
+	SendInvoice(ctx context.Context, id string, email *model.EmailInput) error
+	Complete(ctx context.Context, id string, paymentPending bool) (*model.Order, error)
+
+	BulkAddTags(ctx context.Context, ids []string, tags []string) (*model.Job, error)
+	BulkRemoveTags(ctx context.Context, ids []string, tags []string) (*model.Job, error)
+}
+
+type DraftOrderServiceOp struct {
+	client *Client
+}
+
+var _ DraftOrderService = &DraftOrderServiceOp{}
+
+type mutationDraftOrderDelete struct {
+	DraftOrderDeleteResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"draftOrderDelete(input: $input)" json:"draftOrderDelete"`
+}
+
+type mutationDraftOrderInvoiceSend struct {
+	DraftOrderInvoiceSendResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"draftOrderInvoiceSend(id: $id, email: $email)" json:"draftOrderInvoiceSend"`
+}
+
+type mutationDraftOrderBulkAddTags struct {
+	DraftOrderBulkAddTagsResult struct {
+		Job *struct {
+			ID   string `json:"id,omitempty"`
+			Done bool   `json:"done,omitempty"`
+		} `json:"job,omitempty"`
+
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"draftOrderBulkAddTags(ids: $ids, tags: $tags)" json:"draftOrderBulkAddTags"`
+}
+
+type mutationDraftOrderBulkRemoveTags struct {
+	DraftOrderBulkRemoveTagsResult struct {
+		Job *struct {
+			ID   string `json:"id,omitempty"`
+			Done bool   `json:"done,omitempty"`
+		} `json:"job,omitempty"`
+
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"draftOrderBulkRemoveTags(ids: $ids, tags: $tags)" json:"draftOrderBulkRemoveTags"`
+}
+
+const draftOrderBaseQuery = `
+	id
+	legacyResourceId
+	name
+	status
+	email
+	phone
+	note2
+	tags
+	poNumber
+	ready
+	taxExempt
+	taxesIncluded
+	currencyCode
+	invoiceUrl
+	invoiceSentAt
+	createdAt
+	updatedAt
+	completedAt
+	customer{
+		id
+		legacyResourceId
+		displayName
+		email
+	}
+	shippingAddress{
+		address1
+		address2
+		city
+		province
+		provinceCode
+		country
+		countryCodeV2
+		zip
+	}
+	billingAddress{
+		address1
+		address2
+		city
+		province
+		provinceCode
+		country
+		countryCodeV2
+		zip
+	}
+	appliedDiscount{
+		title
+		description
+		value
+		valueType
+		amountSet{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+	}
+	subtotalPriceSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	totalTaxSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	totalPriceSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	order{
+		id
+		legacyResourceId
+		name
+	}
+`
+
+var draftOrderQuery = fmt.Sprintf(`
+	%s
+	lineItems(first:50){
+		edges{
+			node{
+				...draftOrderLineItem
+			}
+		}
+	}
+`, draftOrderBaseQuery)
+
+var draftOrderBulkQuery = fmt.Sprintf(`
+	%s
+	lineItems{
+		edges{
+			node{
+				...draftOrderLineItem
+			}
+		}
+	}
+`, draftOrderBaseQuery)
+
+const draftOrderLineItemFragment = `
+fragment draftOrderLineItem on DraftOrderLineItem {
+	id
+	sku
+	name
+	title
+	variantTitle
+	vendor
+	quantity
+	custom
+	requiresShipping
+	taxable
+	product{
+		id
+		legacyResourceId
+	}
+	variant{
+		id
+		legacyResourceId
+	}
+	originalUnitPriceSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	discountedTotalSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+}
+`
+
+const calculatedDraftOrderQuery = `
+	currencyCode
+	taxesIncluded
+	totalQuantityOfLineItems
+	lineItems{
+		sku
+		name
+		title
+		variantTitle
+		quantity
+		custom
+		originalUnitPriceSet{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+		discountedTotalSet{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+	}
+	availableShippingRates{
+		handle
+		title
+		price{
+			amount
+			currencyCode
+		}
+	}
+	subtotalPriceSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	totalDiscountsSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	totalShippingPriceSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	totalTaxSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	totalPriceSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+`
+
+func (s *DraftOrderServiceOp) Get(ctx context.Context, id string, fields ...FieldSelector) (*model.DraftOrder, error) {
+	sel, err := selectFields(fields, "", false, selectionSet{fields: draftOrderQuery, fragments: draftOrderLineItemFragment})
+	if err != nil {
+		return nil, err
+	}
+
+	q := fmt.Sprintf(`
+		query draftOrder($id: ID!) {
+			draftOrder(id: $id){
+				%s
+			}
+		}
+
+		%s
+	`, sel.fields, sel.fragments)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	out := struct {
+		DraftOrder *model.DraftOrder `json:"draftOrder"`
+	}{}
+	err = s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	if out.DraftOrder == nil {
+		return nil, fmt.Errorf("draft order %s: %w", id, ErrNotFound)
+	}
+
+	return out.DraftOrder, nil
+}
+
+func (s *DraftOrderServiceOp) List(ctx context.Context, opts ListOptions, fields ...FieldSelector) ([]model.DraftOrder, error) {
+	sel, err := selectFields(fields, "", true, selectionSet{fields: draftOrderBulkQuery, fragments: draftOrderLineItemFragment})
+	if err != nil {
+		return nil, err
+	}
+
+	q := fmt.Sprintf(`
+		{
+			draftOrders(query: %s){
+				edges{
+					node{
+						%s
+					}
+				}
+			}
+		}
+
+		%s
+	`, graphQLString(opts.Query), sel.fields, sel.fragments)
+
+	res := []model.DraftOrder{}
+	err = s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	if err != nil {
+		return nil, fmt.Errorf("bulk query: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *DraftOrderServiceOp) ListAll(ctx context.Context, fields ...FieldSelector) ([]model.DraftOrder, error) {
+	sel, err := selectFields(fields, "", true, selectionSet{fields: draftOrderBulkQuery, fragments: draftOrderLineItemFragment})
+	if err != nil {
+		return nil, err
+	}
+
+	q := fmt.Sprintf(`
+		{
+			draftOrders{
+				edges{
+					node{
+						%s
+					}
+				}
+			}
+		}
+
+		%s
+	`, sel.fields, sel.fragments)
+
+	res := []model.DraftOrder{}
+	err = s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	if err != nil {
+		return nil, fmt.Errorf("bulk query: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *DraftOrderServiceOp) Create(ctx context.Context, input model.DraftOrderInput) (*model.DraftOrder, error) {
+	m := fmt.Sprintf(`
+		mutation draftOrderCreate($input: DraftOrderInput!) {
+			draftOrderCreate(input: $input){
+				draftOrder{
+					%s
+				}
+				userErrors{
+					field
+					message
+				}
+			}
+		}
+
+		%s
+	`, draftOrderQuery, draftOrderLineItemFragment)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	out := struct {
+		DraftOrderCreateResult model.DraftOrderCreatePayload `json:"draftOrderCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.DraftOrderCreateResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return out.DraftOrderCreateResult.DraftOrder, nil
+}
+
+func (s *DraftOrderServiceOp) Update(ctx context.Context, id string, input model.DraftOrderInput) (*model.DraftOrder, error) {
+	m := fmt.Sprintf(`
+		mutation draftOrderUpdate($id: ID!, $input: DraftOrderInput!) {
+			draftOrderUpdate(id: $id, input: $input){
+				draftOrder{
+					%s
+				}
+				userErrors{
+					field
+					message
+				}
+			}
+		}
+
+		%s
+	`, draftOrderQuery, draftOrderLineItemFragment)
+
+	vars := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+
+	out := struct {
+		DraftOrderUpdateResult model.DraftOrderUpdatePayload `json:"draftOrderUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.DraftOrderUpdateResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return out.DraftOrderUpdateResult.DraftOrder, nil
+}
+
+// Calculate returns the totals, taxes and available shipping rates of the draft order input without saving it.
+func (s *DraftOrderServiceOp) Calculate(ctx context.Context, input model.DraftOrderInput) (*model.CalculatedDraftOrder, error) {
+	m := fmt.Sprintf(`
+		mutation draftOrderCalculate($input: DraftOrderInput!) {
+			draftOrderCalculate(input: $input){
+				calculatedDraftOrder{
+					%s
+				}
+				userErrors{
+					field
+					message
+				}
+			}
+		}
+	`, calculatedDraftOrderQuery)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	out := struct {
+		DraftOrderCalculateResult model.DraftOrderCalculatePayload `json:"draftOrderCalculate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.DraftOrderCalculateResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return out.DraftOrderCalculateResult.CalculatedDraftOrder, nil
+}
+
+func (s *DraftOrderServiceOp) Duplicate(ctx context.Context, id string) (*model.DraftOrder, error) {
+	m := fmt.Sprintf(`
+		mutation draftOrderDuplicate($id: ID!) {
+			draftOrderDuplicate(id: $id){
+				draftOrder{
+					%s
+				}
+				userErrors{
+					field
+					message
+				}
+			}
+		}
+
+		%s
+	`, draftOrderQuery, draftOrderLineItemFragment)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	out := struct {
+		DraftOrderDuplicateResult model.DraftOrderDuplicatePayload `json:"draftOrderDuplicate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.DraftOrderDuplicateResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return out.DraftOrderDuplicateResult.DraftOrder, nil
+}
+
+func (s *DraftOrderServiceOp) Delete(ctx context.Context, id string) error {
+	m := mutationDraftOrderDelete{}
+
+	vars := map[string]interface{}{
+		"input": model.DraftOrderDeleteInput{ID: id},
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.DraftOrderDeleteResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SendInvoice emails the invoice of the draft order, to the customer email unless the email input overrides it.
+func (s *DraftOrderServiceOp) SendInvoice(ctx context.Context, id string, email *model.EmailInput) error {
+	m := mutationDraftOrderInvoiceSend{}
+
+	vars := map[string]interface{}{
+		"id":    id,
+		"email": email,
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.DraftOrderInvoiceSendResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Complete turns the draft order into an order and returns it. The order is marked as paid unless the payment is pending.
+func (s *DraftOrderServiceOp) Complete(ctx context.Context, id string, paymentPending bool) (*model.Order, error) {
+	m := fmt.Sprintf(`
+		mutation draftOrderComplete($id: ID!, $paymentPending: Boolean) {
+			draftOrderComplete(id: $id, paymentPending: $paymentPending){
+				draftOrder{
+					id
+					order{
+						%s
+					}
+				}
+				userErrors{
+					field
+					message
+				}
+			}
+		}
+
+		%s
+	`, orderQuery, lineItemFragment)
+
+	vars := map[string]interface{}{
+		"id":             id,
+		"paymentPending": paymentPending,
+	}
+
+	out := struct {
+		DraftOrderCompleteResult model.DraftOrderCompletePayload `json:"draftOrderComplete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.DraftOrderCompleteResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	if out.DraftOrderCompleteResult.DraftOrder == nil || out.DraftOrderCompleteResult.DraftOrder.Order == nil {
+		return nil, fmt.Errorf("draft order %s: no order completed", id)
+	}
+
+	return out.DraftOrderCompleteResult.DraftOrder.Order, nil
+}
+
+// BulkAddTags adds the tags to the draft orders asynchronously and returns the job doing it.
+func (s *DraftOrderServiceOp) BulkAddTags(ctx context.Context, ids []string, tags []string) (*model.Job, error) {
+	m := mutationDraftOrderBulkAddTags{}
+
+	vars := map[string]interface{}{
+		"ids":  ids,
+		"tags": graphQLStrings(tags),
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.DraftOrderBulkAddTagsResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	if m.DraftOrderBulkAddTagsResult.Job == nil {
+		return nil, fmt.Errorf("draft orders: no tagging job")
+	}
+
+	return &model.Job{ID: m.DraftOrderBulkAddTagsResult.Job.ID, Done: m.DraftOrderBulkAddTagsResult.Job.Done}, nil
+}
+
+// BulkRemoveTags removes the tags from the draft orders asynchronously and returns the job doing it.
+func (s *DraftOrderServiceOp) BulkRemoveTags(ctx context.Context, ids []string, tags []string) (*model.Job, error) {
+	m := mutationDraftOrderBulkRemoveTags{}
+
+	vars := map[string]interface{}{
+		"ids":  ids,
+		"tags": graphQLStrings(tags),
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.DraftOrderBulkRemoveTagsResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	if m.DraftOrderBulkRemoveTagsResult.Job == nil {
+		return nil, fmt.Errorf("draft orders: no tagging job")
+	}
+
+	return &model.Job{ID: m.DraftOrderBulkRemoveTagsResult.Job.ID, Done: m.DraftOrderBulkRemoveTagsResult.Job.Done}, nil
+}
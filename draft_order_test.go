@@ -0,0 +1,215 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestDraftOrderCreate(t *testing.T) {
+	srv := newGraphQLServer(t, `{"draftOrderCreate":{"draftOrder":{"id":"gid://shopify/DraftOrder/1","name":"#D1","lineItems":{"edges":[{"node":{"id":"gid://shopify/DraftOrderLineItem/1","quantity":2}}]}}}}`)
+	c := srv.client(t)
+
+	draftOrder, err := c.DraftOrder.Create(context.Background(), model.DraftOrderInput{
+		Email: ptr("jane@example.com"),
+		LineItems: []model.DraftOrderLineItemInput{
+			{VariantID: ptr("gid://shopify/ProductVariant/1"), Quantity: 2},
+			{Title: ptr("Gift wrap"), Quantity: 1, OriginalUnitPriceWithCurrency: &model.MoneyInput{Amount: null.StringFrom("5.00"), CurrencyCode: model.CurrencyCodeEur}},
+		},
+		AppliedDiscount: &model.DraftOrderAppliedDiscountInput{Value: 10, ValueType: model.DraftOrderAppliedDiscountTypePercentage, Title: ptr("Wholesale")},
+		ShippingLine:    &model.ShippingLineInput{Title: ptr("Express"), PriceWithCurrency: &model.MoneyInput{Amount: null.StringFrom("9.90"), CurrencyCode: model.CurrencyCodeEur}},
+		Tags:            []string{"wholesale", "b2b"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/DraftOrder/1", draftOrder.ID)
+	require.Len(t, draftOrder.LineItems.Edges, 1)
+	assert.Equal(t, 2, draftOrder.LineItems.Edges[0].Node.Quantity)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation draftOrderCreate($input: DraftOrderInput!)")
+	assert.Contains(t, req.Query, "draftOrderCreate(input: $input)")
+	assert.Contains(t, req.Query, "fragment draftOrderLineItem on DraftOrderLineItem")
+	assert.Equal(t, map[string]interface{}{
+		"email": "jane@example.com",
+		"lineItems": []interface{}{
+			map[string]interface{}{"variantId": "gid://shopify/ProductVariant/1", "quantity": float64(2)},
+			map[string]interface{}{"title": "Gift wrap", "quantity": float64(1), "originalUnitPriceWithCurrency": map[string]interface{}{"amount": "5.00", "currencyCode": "EUR"}},
+		},
+		"appliedDiscount": map[string]interface{}{"value": float64(10), "valueType": "PERCENTAGE", "title": "Wholesale"},
+		"shippingLine":    map[string]interface{}{"title": "Express", "priceWithCurrency": map[string]interface{}{"amount": "9.90", "currencyCode": "EUR"}},
+		"tags":            []interface{}{"wholesale", "b2b"},
+	}, req.Variables["input"])
+}
+
+func TestDraftOrderUpdate(t *testing.T) {
+	srv := newGraphQLServer(t, `{"draftOrderUpdate":{"userErrors":[{"field":["input","lineItems","0","variantId"],"message":"Variant is invalid"}]}}`)
+	c := srv.client(t)
+
+	_, err := c.DraftOrder.Update(context.Background(), "gid://shopify/DraftOrder/1", model.DraftOrderInput{
+		Note:      ptr("Call before delivery"),
+		LineItems: []model.DraftOrderLineItemInput{{VariantID: ptr("gid://shopify/ProductVariant/404"), Quantity: 1}},
+	})
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, []string{"input", "lineItems", "0", "variantId"}, userErrs.Errors[0].Field)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation draftOrderUpdate($id: ID!, $input: DraftOrderInput!)")
+	assert.Contains(t, req.Query, "draftOrderUpdate(id: $id, input: $input)")
+	assert.Equal(t, map[string]interface{}{
+		"id": "gid://shopify/DraftOrder/1",
+		"input": map[string]interface{}{
+			"note":      "Call before delivery",
+			"lineItems": []interface{}{map[string]interface{}{"variantId": "gid://shopify/ProductVariant/404", "quantity": float64(1)}},
+		},
+	}, req.Variables)
+}
+
+func TestDraftOrderCalculate(t *testing.T) {
+	srv := newGraphQLServer(t, `{"draftOrderCalculate":{"calculatedDraftOrder":{
+		"totalPriceSet":{"shopMoney":{"amount":"29.80","currencyCode":"EUR"}},
+		"availableShippingRates":[{"handle":"express","title":"Express","price":{"amount":"9.90","currencyCode":"EUR"}}]
+	}}}`)
+	c := srv.client(t)
+
+	calculated, err := c.DraftOrder.Calculate(context.Background(), model.DraftOrderInput{
+		LineItems:       []model.DraftOrderLineItemInput{{VariantID: ptr("gid://shopify/ProductVariant/1"), Quantity: 2}},
+		ShippingAddress: &model.MailingAddressInput{City: ptr("Berlin"), CountryCode: ptr(model.CountryCodeDe)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "29.80", calculated.TotalPriceSet.ShopMoney.Amount.String)
+	require.Len(t, calculated.AvailableShippingRates, 1)
+	assert.Equal(t, "express", calculated.AvailableShippingRates[0].Handle)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation draftOrderCalculate($input: DraftOrderInput!)")
+	assert.Contains(t, req.Query, "calculatedDraftOrder{")
+	assert.Equal(t, map[string]interface{}{
+		"lineItems":       []interface{}{map[string]interface{}{"variantId": "gid://shopify/ProductVariant/1", "quantity": float64(2)}},
+		"shippingAddress": map[string]interface{}{"city": "Berlin", "countryCode": "DE"},
+	}, req.Variables["input"])
+}
+
+func TestDraftOrderComplete(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"draftOrderComplete":{"draftOrder":{"id":"gid://shopify/DraftOrder/1","order":{"id":"gid://shopify/Order/1","name":"#1001"}}}}`,
+		`{"draftOrderComplete":{"userErrors":[{"field":["id"],"message":"Draft order has already been completed"}]}}`,
+	)
+	c := srv.client(t)
+
+	order, err := c.DraftOrder.Complete(context.Background(), "gid://shopify/DraftOrder/1", true)
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/Order/1", order.ID)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation draftOrderComplete($id: ID!, $paymentPending: Boolean)")
+	assert.Contains(t, req.Query, "draftOrderComplete(id: $id, paymentPending: $paymentPending)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/DraftOrder/1", "paymentPending": true}, req.Variables)
+
+	_, err = c.DraftOrder.Complete(context.Background(), "gid://shopify/DraftOrder/1", false)
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, "Draft order has already been completed", userErrs.Errors[0].Message)
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/DraftOrder/1", "paymentPending": false}, srv.last(t).Variables, "the order is marked as paid")
+}
+
+func TestDraftOrderBulkTags(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"draftOrderBulkAddTags":{"job":{"id":"gid://shopify/Job/1","done":false}}}`,
+		`{"draftOrderBulkRemoveTags":{"job":{"id":"gid://shopify/Job/2","done":true}}}`,
+		`{"draftOrderBulkRemoveTags":{"userErrors":[{"field":["ids"],"message":"Draft orders not found"}]}}`,
+	)
+	c := srv.client(t)
+
+	ids := []string{"gid://shopify/DraftOrder/1", "gid://shopify/DraftOrder/2"}
+
+	job, err := c.DraftOrder.BulkAddTags(context.Background(), ids, []string{"wholesale"})
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/Job/1", job.ID)
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation($ids:[ID!]!$tags:[String!]!){draftOrderBulkAddTags(ids: $ids, tags: $tags)")
+	assert.Equal(t, map[string]interface{}{
+		"ids":  []interface{}{"gid://shopify/DraftOrder/1", "gid://shopify/DraftOrder/2"},
+		"tags": []interface{}{"wholesale"},
+	}, req.Variables)
+
+	job, err = c.DraftOrder.BulkRemoveTags(context.Background(), ids, []string{"wholesale", "b2b"})
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/Job/2", job.ID)
+	assert.True(t, job.Done)
+	req = srv.last(t)
+	assert.Contains(t, req.Query, "mutation($ids:[ID!]!$tags:[String!]!){draftOrderBulkRemoveTags(ids: $ids, tags: $tags)")
+	assert.Equal(t, []interface{}{"wholesale", "b2b"}, req.Variables["tags"])
+
+	_, err = c.DraftOrder.BulkRemoveTags(context.Background(), []string{"gid://shopify/DraftOrder/3"}, []string{"b2b"})
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, []string{"ids"}, userErrs.Errors[0].Field)
+}
+
+func TestDraftOrderSendInvoice(t *testing.T) {
+	srv := newGraphQLServer(t, `{"draftOrderInvoiceSend":{"userErrors":[{"field":["email","to"],"message":"To is invalid"}]}}`)
+	c := srv.client(t)
+
+	err := c.DraftOrder.SendInvoice(context.Background(), "gid://shopify/DraftOrder/1", &model.EmailInput{To: ptr("not-an-email")})
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, "To is invalid", userErrs.Errors[0].Message)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation($email:EmailInput$id:ID!){draftOrderInvoiceSend(id: $id, email: $email)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/DraftOrder/1", "email": map[string]interface{}{"to": "not-an-email"}}, req.Variables)
+}
+
+// serveDraftOrdersBulkResult makes the server run a bulk operation resulting in two draft orders, the first with a line item.
+func serveDraftOrdersBulkResult(srv *graphQLServer) {
+	srv.responses = []string{
+		`{"bulkOperationRunQuery":{"bulkOperation":{"id":"gid://shopify/BulkOperation/1","createdAt":"2024-01-01T10:00:00Z"},"userErrors":[]}}`,
+		fmt.Sprintf(`{"node":{"id":"gid://shopify/BulkOperation/1","status":"COMPLETED","objectCount":"3","url":%q}}`, srv.URL+"/result.jsonl"),
+	}
+	srv.bulkResult = `{"id":"gid://shopify/DraftOrder/1","name":"#D1"}
+{"id":"gid://shopify/DraftOrderLineItem/1","quantity":2,"__parentId":"gid://shopify/DraftOrder/1"}
+{"id":"gid://shopify/DraftOrder/2","name":"#D2"}
+`
+}
+
+func TestDraftOrderList(t *testing.T) {
+	srv := newGraphQLServer(t)
+	serveDraftOrdersBulkResult(srv)
+	c := srv.client(t)
+
+	draftOrders, err := c.DraftOrder.List(context.Background(), ListOptions{Query: Search().Field("status", "open").Field("tag", "b2b").String()})
+	require.NoError(t, err)
+	require.Len(t, draftOrders, 2)
+	assert.Equal(t, "#D1", draftOrders[0].Name)
+	require.Len(t, draftOrders[0].LineItems.Edges, 1, "the line items are attached to their draft order")
+	assert.Equal(t, 2, draftOrders[0].LineItems.Edges[0].Node.Quantity)
+	assert.Equal(t, "#D2", draftOrders[1].Name)
+
+	post := srv.request(t, 0)
+	assert.Contains(t, post.Query, "bulkOperationRunQuery(query: $query)")
+	assert.Contains(t, post.Variables["query"], `draftOrders(query: "status:open AND tag:b2b")`)
+	assert.Contains(t, post.Variables["query"], "lineItems{", "the line items are queried entirely")
+}
+
+func TestDraftOrderListAll(t *testing.T) {
+	srv := newGraphQLServer(t)
+	serveDraftOrdersBulkResult(srv)
+	c := srv.client(t)
+
+	draftOrders, err := c.DraftOrder.ListAll(context.Background(), Select("name").Connection("lineItems", Select("quantity")))
+	require.NoError(t, err)
+	require.Len(t, draftOrders, 2)
+	require.Len(t, draftOrders[0].LineItems.Edges, 1)
+
+	query := srv.request(t, 0).Variables["query"].(string)
+	assert.Contains(t, query, "draftOrders{")
+	assert.NotContains(t, query, "draftOrders(query:")
+	assert.Contains(t, query, "lineItems{")
+	assert.NotContains(t, query, "draftOrderLineItem", "the selection replaces the default fields")
+}
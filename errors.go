@@ -0,0 +1,163 @@
+package shopify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
+)
+
+var (
+	ErrThrottled       = errors.New("throttled")
+	ErrNotFound        = errors.New("not found")
+	ErrAccessDenied    = errors.New("access denied")
+	ErrMaxCostExceeded = errors.New("max cost exceeded")
+)
+
+// GraphQLError is an error from the `errors` field of a GraphQL response.
+type GraphQLError struct {
+	Message    string
+	Code       string
+	Path       []interface{}
+	Extensions map[string]interface{}
+}
+
+func (e *GraphQLError) Error() string {
+	if e.Code == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s (%s)", e.Message, e.Code)
+}
+
+func (e *GraphQLError) Is(target error) bool {
+	switch target {
+	case ErrThrottled:
+		return e.Code == "THROTTLED"
+	case ErrNotFound:
+		return e.Code == "NOT_FOUND"
+	case ErrAccessDenied:
+		return e.Code == "ACCESS_DENIED"
+	case ErrMaxCostExceeded:
+		return e.Code == "MAX_COST_EXCEEDED"
+	}
+	return false
+}
+
+// UserError is a mutation user error along with its code, if the mutation payload provides one.
+type UserError struct {
+	model.UserError
+	Code string
+}
+
+// UserErrorsError is returned when a mutation responds with user errors.
+type UserErrorsError struct {
+	Errors []UserError
+}
+
+func (e *UserErrorsError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, ue := range e.Errors {
+		msg := ue.Message
+		if len(ue.Field) > 0 {
+			msg = fmt.Sprintf("%s: %s", strings.Join(ue.Field, "."), msg)
+		}
+		if ue.Code != "" {
+			msg = fmt.Sprintf("%s (%s)", msg, ue.Code)
+		}
+		msgs = append(msgs, msg)
+	}
+	return fmt.Sprintf("user errors: %s", strings.Join(msgs, "; "))
+}
+
+func (e *UserErrorsError) Is(target error) bool {
+	for _, ue := range e.Errors {
+		switch {
+		case target == ErrNotFound && ue.Code == "NOT_FOUND":
+			return true
+		case target == ErrAccessDenied && ue.Code == "ACCESS_DENIED":
+			return true
+		}
+	}
+	return false
+}
+
+// newUserErrorsError returns a *UserErrorsError for the given mutation user errors, or nil if there are none.
+func newUserErrorsError[E model.DisplayableError](errs []E) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	out := &UserErrorsError{
+		Errors: make([]UserError, 0, len(errs)),
+	}
+	for _, e := range errs {
+		out.Errors = append(out.Errors, UserError{
+			UserError: model.UserError{
+				Field:   e.GetField(),
+				Message: e.GetMessage(),
+			},
+			Code: userErrorCode(e),
+		})
+	}
+
+	return out
+}
+
+func userErrorCode(e model.DisplayableError) string {
+	v := reflect.Indirect(reflect.ValueOf(e))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	code := v.FieldByName("Code")
+	if !code.IsValid() {
+		return ""
+	}
+	if code.Kind() == reflect.Ptr {
+		if code.IsNil() {
+			return ""
+		}
+		code = code.Elem()
+	}
+
+	return fmt.Sprint(code.Interface())
+}
+
+// wrapGraphQLError converts the error returned by the GraphQL client into the typed errors,
+// using the response details recorded by the transport.
+func wrapGraphQLError(err error, info *graphqlclient.ResponseInfo) error {
+	if err == nil || info == nil {
+		return err
+	}
+
+	if len(info.Errors) > 0 {
+		errs := make([]error, 0, len(info.Errors))
+		for _, e := range info.Errors {
+			errs = append(errs, &GraphQLError{
+				Message:    e.Message,
+				Code:       e.Code(),
+				Path:       e.Path,
+				Extensions: e.Extensions,
+			})
+		}
+		if len(errs) == 1 {
+			return errs[0]
+		}
+		return errors.Join(errs...)
+	}
+
+	switch info.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: %w", ErrAccessDenied, err)
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: %w", ErrThrottled, err)
+	}
+
+	return err
+}
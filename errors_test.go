@@ -0,0 +1,77 @@
+package shopify
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserErrorsError(t *testing.T) {
+	assert.NoError(t, newUserErrorsError([]model.UserError{}))
+
+	code := model.BulkOperationUserErrorCodeOperationInProgress
+	err := newUserErrorsError([]model.BulkOperationUserError{{
+		Code:    &code,
+		Field:   []string{"query"},
+		Message: "A bulk query operation for this app and shop is already in progress",
+	}})
+
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, fmt.Errorf("post bulk query: %w", err), &userErrs)
+	require.Len(t, userErrs.Errors, 1)
+	assert.Equal(t, []string{"query"}, userErrs.Errors[0].Field)
+	assert.Equal(t, "OPERATION_IN_PROGRESS", userErrs.Errors[0].Code)
+	assert.Equal(t, "user errors: query: A bulk query operation for this app and shop is already in progress (OPERATION_IN_PROGRESS)", err.Error())
+}
+
+func TestWrapGraphQLError(t *testing.T) {
+	clientErr := errors.New("client error")
+
+	tests := []struct {
+		name     string
+		info     *graphqlclient.ResponseInfo
+		expected error
+	}{{
+		name: "throttled",
+		info: &graphqlclient.ResponseInfo{
+			StatusCode: 200,
+			Errors: []graphqlclient.Error{{
+				Message:    "Throttled",
+				Extensions: map[string]interface{}{"code": "THROTTLED"},
+			}},
+		},
+		expected: ErrThrottled,
+	}, {
+		name: "access denied",
+		info: &graphqlclient.ResponseInfo{
+			StatusCode: 200,
+			Errors: []graphqlclient.Error{{
+				Message: "Field 'orders' doesn't exist on type 'QueryRoot'",
+			}, {
+				Message:    "Access denied for orders field.",
+				Extensions: map[string]interface{}{"code": "ACCESS_DENIED"},
+			}},
+		},
+		expected: ErrAccessDenied,
+	}, {
+		name:     "unauthorized",
+		info:     &graphqlclient.ResponseInfo{StatusCode: 401},
+		expected: ErrAccessDenied,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fmt.Errorf("query: %w", wrapGraphQLError(clientErr, tt.info))
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+
+	var gqlErr *GraphQLError
+	err := wrapGraphQLError(clientErr, tests[0].info)
+	require.ErrorAs(t, err, &gqlErr)
+	assert.Equal(t, "THROTTLED", gqlErr.Code)
+}
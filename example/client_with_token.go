@@ -6,6 +6,6 @@ import (
 	shopify "github.com/r0busta/go-shopify-graphql/v9"
 )
 
-func clientWithToken() *shopify.Client {
-	return shopify.NewClientWithToken(os.Getenv("STORE_ACCESS_TOKEN"), os.Getenv("STORE_NAME"))
+func clientWithToken() (*shopify.Client, error) {
+	return shopify.NewClientWithTokenE(os.Getenv("STORE_ACCESS_TOKEN"), os.Getenv("STORE_NAME"))
 }
@@ -7,8 +7,8 @@ import (
 	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
 )
 
-func clientWithVersion() *shopify.Client {
+func clientWithVersion() (*shopify.Client, error) {
 	gqlClient := graphqlclient.NewClient(os.Getenv("STORE_NAME"), graphqlclient.WithToken(os.Getenv("STORE_ACCESS_TOKEN")), graphqlclient.WithVersion("2022-10"))
 
-	return shopify.NewClient(shopify.WithGraphQLClient(gqlClient))
+	return shopify.NewClientE(shopify.WithGraphQLClient(gqlClient))
 }
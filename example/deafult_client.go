@@ -7,11 +7,7 @@ import (
 	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
 )
 
-func defaultClient() *shopify.Client {
-	if os.Getenv("STORE_API_KEY") == "" || os.Getenv("STORE_PASSWORD") == "" || os.Getenv("STORE_NAME") == "" {
-		panic("Shopify Admin API Key and/or Password (aka access token) and/or store name not set")
-	}
-
+func defaultClient() (*shopify.Client, error) {
 	if os.Getenv("STORE_API_VERSION") != "" {
 		apiKey := os.Getenv("STORE_API_KEY")
 		accessToken := os.Getenv("STORE_PASSWORD")
@@ -23,8 +19,8 @@ func defaultClient() *shopify.Client {
 
 		gql := graphqlclient.NewClient(storeName, opts...)
 
-		return shopify.NewClient(shopify.WithGraphQLClient(gql))
+		return shopify.NewClientE(shopify.WithGraphQLClient(gql))
 	}
 
-	return shopify.NewDefaultClient()
+	return shopify.NewDefaultClientE()
 }
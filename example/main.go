@@ -1,9 +1,12 @@
 package main
 
 func main() {
-	client := defaultClient()
-	// client := clientWithToken()
-	// client := clientWithVersion()
+	client, err := defaultClient()
+	// client, err := clientWithToken()
+	// client, err := clientWithVersion()
+	if err != nil {
+		panic(err)
+	}
 
 	// Collections
 	collections(client)
@@ -0,0 +1,44 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// fakeGraphQL responds to the string queries and the mutations with the queued responses, or the last one, and records their variables
+// and the last string query.
+type fakeGraphQL struct {
+	responses []string
+	query     string
+	variables map[string]interface{}
+}
+
+func (f *fakeGraphQL) next() []byte {
+	res := f.responses[0]
+	if len(f.responses) > 1 {
+		f.responses = f.responses[1:]
+	}
+	return []byte(res)
+}
+
+func (f *fakeGraphQL) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	return errors.New("not implemented")
+}
+
+func (f *fakeGraphQL) QueryString(ctx context.Context, q string, variables map[string]interface{}, v interface{}) error {
+	f.query = q
+	f.variables = variables
+	return json.Unmarshal(f.next(), v)
+}
+
+func (f *fakeGraphQL) Mutate(ctx context.Context, m interface{}, variables map[string]interface{}) error {
+	f.variables = variables
+	return json.Unmarshal(f.next(), m)
+}
+
+func (f *fakeGraphQL) MutateString(ctx context.Context, m string, variables map[string]interface{}, v interface{}) error {
+	f.query = m
+	f.variables = variables
+	return json.Unmarshal(f.next(), v)
+}
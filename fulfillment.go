@@ -35,8 +35,8 @@ func (s *FulfillmentServiceOp) Create(ctx context.Context, fulfillment model.Ful
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.FulfillmentCreateV2Result.UserErrors) > 0 {
-		return fmt.Errorf("UserErrors: %+v", m.FulfillmentCreateV2Result.UserErrors)
+	if err := newUserErrorsError(m.FulfillmentCreateV2Result.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
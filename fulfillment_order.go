@@ -0,0 +1,626 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+)
+
+const (
+	// fulfillmentOrdersPageSize is the default page size of the fulfillment order lists, small enough to query their line items along.
+	fulfillmentOrdersPageSize = 10
+	// fulfillmentOrderLineItemsPageSize is the number of line items queried per fulfillment order and per page of their line items.
+	fulfillmentOrderLineItemsPageSize = 250
+)
+
+//go:generate mockgen -destination=./mock/fulfillment_order_service.go -package=mock . FulfillmentOrderService
+type FulfillmentOrderService interface {
+	Get(ctx context.Context, id string) (*model.FulfillmentOrder, error)
+	ListByOrder(ctx context.Context, orderID string) ([]model.FulfillmentOrder, error)
+
+	ListAssigned(ctx context.Context, opts AssignedFulfillmentOrdersOptions) ([]model.FulfillmentOrder, error)
+	PaginateAssigned(opts AssignedFulfillmentOrdersOptions) *FulfillmentOrderPaginator
+
+	Hold(ctx context.Context, id string, input model.FulfillmentOrderHoldInput) (*model.FulfillmentOrderHoldPayload, error)
+	ReleaseHold(ctx context.Context, id string, holdIDs []string) (*model.FulfillmentOrder, error)
+	Move(ctx context.Context, id string, newLocationID string, lineItems []model.FulfillmentOrderLineItemInput) (*model.FulfillmentOrderMovePayload, error)
+	Split(ctx context.Context, splits []model.FulfillmentOrderSplitInput) ([]model.FulfillmentOrderSplitResult, error)
+	Merge(ctx context.Context, merges []model.FulfillmentOrderMergeInput) ([]model.FulfillmentOrderMergeResult, error)
+	Reschedule(ctx context.Context, id string, fulfillAt time.Time) (*model.FulfillmentOrder, error)
+
+	SubmitFulfillmentRequest(ctx context.Context, id string, message string, notifyCustomer bool) (*model.FulfillmentOrderSubmitFulfillmentRequestPayload, error)
+	AcceptFulfillmentRequest(ctx context.Context, id string, message string) (*model.FulfillmentOrder, error)
+	RejectFulfillmentRequest(ctx context.Context, id string, message string) (*model.FulfillmentOrder, error)
+
+	SubmitCancellationRequest(ctx context.Context, id string, message string) (*model.FulfillmentOrder, error)
+	AcceptCancellationRequest(ctx context.Context, id string, message string) (*model.FulfillmentOrder, error)
+	RejectCancellationRequest(ctx context.Context, id string, message string) (*model.FulfillmentOrder, error)
+}
+
+type FulfillmentOrderServiceOp struct {
+	client *Client
+}
+
+var _ FulfillmentOrderService = &FulfillmentOrderServiceOp{}
+
+// FulfillmentOrderPaginator pages through the fulfillment orders.
+type FulfillmentOrderPaginator struct {
+	*Paginator[model.FulfillmentOrder]
+}
+
+// AssignedFulfillmentOrdersOptions filter the fulfillment orders assigned to the locations of the app's fulfillment service.
+// The query of the list options isn't supported.
+type AssignedFulfillmentOrdersOptions struct {
+	ListOptions
+	// AssignmentStatus is the request status of the fulfillment orders, all of them if empty.
+	AssignmentStatus model.FulfillmentOrderAssignmentStatus
+	// LocationIDs are the assigned locations, all of them if empty.
+	LocationIDs []string
+}
+
+// fulfillmentOrderPayload is the payload of the mutations changing a single fulfillment order.
+type fulfillmentOrderPayload[E model.DisplayableError] struct {
+	FulfillmentOrder *model.FulfillmentOrder `json:"fulfillmentOrder,omitempty"`
+	UserErrors       []E                     `json:"userErrors,omitempty"`
+}
+
+const fulfillmentOrderBaseQuery = `
+	id
+	orderId
+	orderName
+	status
+	requestStatus
+	fulfillAt
+	fulfillBy
+	createdAt
+	updatedAt
+	assignedLocation{
+		name
+		location{
+			id
+			legacyResourceId
+		}
+	}
+	destination{
+		id
+		firstName
+		lastName
+		company
+		address1
+		address2
+		city
+		province
+		countryCode
+		zip
+		phone
+		email
+	}
+	fulfillmentHolds{
+		id
+		reason
+		reasonNotes
+	}
+	supportedActions{
+		action
+	}
+`
+
+var fulfillmentOrderQuery = fmt.Sprintf(`
+	%s
+	lineItems(first:%d){
+		edges{
+			node{
+				...fulfillmentOrderLineItem
+			}
+		}
+	}
+`, fulfillmentOrderBaseQuery, fulfillmentOrderLineItemsPageSize)
+
+// fulfillmentOrderPagedQuery selects the fulfillment order along with the first page of its line items, to page through the rest of them.
+var fulfillmentOrderPagedQuery = fmt.Sprintf(`
+	%s
+	lineItems(first:%d){
+		edges{
+			node{
+				...fulfillmentOrderLineItem
+			}
+			cursor
+		}
+		pageInfo{
+			hasNextPage
+			endCursor
+		}
+	}
+`, fulfillmentOrderBaseQuery, fulfillmentOrderLineItemsPageSize)
+
+const fulfillmentOrderLineItemFragment = `
+fragment fulfillmentOrderLineItem on FulfillmentOrderLineItem {
+	id
+	sku
+	productTitle
+	variantTitle
+	remainingQuantity
+	totalQuantity
+	requiresShipping
+	inventoryItemId
+	lineItem{
+		id
+	}
+	variant{
+		id
+		legacyResourceId
+	}
+}
+`
+
+// fulfillmentOrderPage is a fulfillment order along with a page of its line items.
+type fulfillmentOrderPage struct {
+	*model.FulfillmentOrder
+	LineItems Connection[*model.FulfillmentOrderLineItem] `json:"lineItems"`
+}
+
+// Get returns the fulfillment order along with all its line items.
+func (s *FulfillmentOrderServiceOp) Get(ctx context.Context, id string) (*model.FulfillmentOrder, error) {
+	return s.getLineItemsAfter(ctx, id, "", nil)
+}
+
+// getLineItemsAfter returns the fulfillment order along with the given line items, followed by all its line items after the cursor.
+func (s *FulfillmentOrderServiceOp) getLineItemsAfter(ctx context.Context, id string, after string, edges []model.FulfillmentOrderLineItemEdge) (*model.FulfillmentOrder, error) {
+	q := fmt.Sprintf(`
+		query fulfillmentOrder($id: ID!, $first: Int, $after: String) {
+			fulfillmentOrder(id: $id){
+				%s
+				lineItems(first: $first, after: $after){
+					edges{
+						node{
+							...fulfillmentOrderLineItem
+						}
+						cursor
+					}
+					pageInfo{
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+
+		%s
+	`, fulfillmentOrderBaseQuery, fulfillmentOrderLineItemFragment)
+
+	var fulfillmentOrder *model.FulfillmentOrder
+	lineItems := NewPaginator(ListOptions{First: fulfillmentOrderLineItemsPageSize, After: after}, func(ctx context.Context, args PageArgs) (*Connection[*model.FulfillmentOrderLineItem], error) {
+		vars := args.Variables(map[string]interface{}{
+			"id": id,
+		})
+
+		out := struct {
+			FulfillmentOrder *fulfillmentOrderPage `json:"fulfillmentOrder"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, fmt.Errorf("query: %w", err)
+		}
+
+		if out.FulfillmentOrder == nil || out.FulfillmentOrder.FulfillmentOrder == nil {
+			return nil, fmt.Errorf("fulfillment order %s: %w", id, ErrNotFound)
+		}
+
+		if fulfillmentOrder == nil {
+			fulfillmentOrder = out.FulfillmentOrder.FulfillmentOrder
+		}
+		return &out.FulfillmentOrder.LineItems, nil
+	})
+
+	edges = append([]model.FulfillmentOrderLineItemEdge{}, edges...)
+	for lineItems.HasNext() {
+		page, err := lineItems.NextPage(ctx)
+		if err != nil {
+			if fulfillmentOrder == nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("get page: %w", err)
+		}
+		for _, e := range page.Edges {
+			edges = append(edges, model.FulfillmentOrderLineItemEdge{Cursor: e.Cursor, Node: e.Node})
+		}
+	}
+	fulfillmentOrder.LineItems = &model.FulfillmentOrderLineItemConnection{
+		Edges:    edges,
+		PageInfo: &model.PageInfo{},
+	}
+
+	return fulfillmentOrder, nil
+}
+
+// ListByOrder returns all the fulfillment orders of the order, along with all their line items.
+func (s *FulfillmentOrderServiceOp) ListByOrder(ctx context.Context, orderID string) ([]model.FulfillmentOrder, error) {
+	q := fmt.Sprintf(`
+		query orderFulfillmentOrders($id: ID!, $first: Int, $after: String) {
+			order(id: $id){
+				fulfillmentOrders(first: $first, after: $after){
+					edges{
+						node{
+							%s
+						}
+						cursor
+					}
+					pageInfo{
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+
+		%s
+	`, fulfillmentOrderPagedQuery, fulfillmentOrderLineItemFragment)
+
+	p := NewPaginator(ListOptions{First: fulfillmentOrdersPageSize}, func(ctx context.Context, args PageArgs) (*Connection[model.FulfillmentOrder], error) {
+		vars := args.Variables(map[string]interface{}{
+			"id": orderID,
+		})
+
+		out := struct {
+			Order *struct {
+				FulfillmentOrders Connection[model.FulfillmentOrder] `json:"fulfillmentOrders"`
+			} `json:"order"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, fmt.Errorf("query: %w", err)
+		}
+
+		if out.Order == nil {
+			return nil, fmt.Errorf("order %s: %w", orderID, ErrNotFound)
+		}
+
+		return &out.Order.FulfillmentOrders, nil
+	})
+
+	res := []model.FulfillmentOrder{}
+	for fo, err := range p.All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+
+		if li := fo.LineItems; li != nil && li.PageInfo != nil && li.PageInfo.HasNextPage && li.PageInfo.EndCursor != nil {
+			rest, err := s.getLineItemsAfter(ctx, fo.ID, *li.PageInfo.EndCursor, li.Edges)
+			if err != nil {
+				return nil, fmt.Errorf("fulfillment order %s line items: %w", fo.ID, err)
+			}
+			fo.LineItems = rest.LineItems
+		}
+
+		res = append(res, fo)
+	}
+
+	return res, nil
+}
+
+// ListAssigned returns all the fulfillment orders assigned to the locations of the app's fulfillment service.
+func (s *FulfillmentOrderServiceOp) ListAssigned(ctx context.Context, opts AssignedFulfillmentOrdersOptions) ([]model.FulfillmentOrder, error) {
+	res := []model.FulfillmentOrder{}
+	for fo, err := range s.PaginateAssigned(opts).All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, fo)
+	}
+
+	return res, nil
+}
+
+// PaginateAssigned pages through the fulfillment orders assigned to the locations of the app's fulfillment service,
+// by 10 fulfillment orders with their line items unless ListOptions.First or ListOptions.Last is set.
+func (s *FulfillmentOrderServiceOp) PaginateAssigned(opts AssignedFulfillmentOrdersOptions) *FulfillmentOrderPaginator {
+	listOpts := opts.ListOptions
+	if listOpts.First == 0 && listOpts.Last == 0 {
+		listOpts.First = fulfillmentOrdersPageSize
+	}
+
+	vars := map[string]interface{}{}
+	if opts.AssignmentStatus != "" {
+		vars["assignmentStatus"] = opts.AssignmentStatus
+	}
+	if len(opts.LocationIDs) > 0 {
+		vars["locationIds"] = opts.LocationIDs
+	}
+
+	return &FulfillmentOrderPaginator{NewPaginator(listOpts, fetchConnection[model.FulfillmentOrder](s.client, listOpts, connectionQuery{
+		field:  "assignedFulfillmentOrders",
+		params: []string{"$assignmentStatus: FulfillmentOrderAssignmentStatus", "$locationIds: [ID!]"},
+		vars:   vars,
+		def:    selectionSet{fields: fulfillmentOrderQuery, fragments: fulfillmentOrderLineItemFragment},
+	}))}
+}
+
+// Hold holds the fulfillment order, or the given line items of it, which are then split into the held fulfillment order.
+func (s *FulfillmentOrderServiceOp) Hold(ctx context.Context, id string, input model.FulfillmentOrderHoldInput) (*model.FulfillmentOrderHoldPayload, error) {
+	m := fulfillmentOrderMutation("fulfillmentOrderHold",
+		"$id: ID!, $fulfillmentHold: FulfillmentOrderHoldInput!",
+		"id: $id, fulfillmentHold: $fulfillmentHold",
+		fmt.Sprintf(`
+			fulfillmentHold{
+				id
+				reason
+				reasonNotes
+			}
+			fulfillmentOrder{
+				%[1]s
+			}
+			remainingFulfillmentOrder{
+				%[1]s
+			}
+		`, fulfillmentOrderQuery),
+		"code")
+
+	vars := map[string]interface{}{
+		"id":              id,
+		"fulfillmentHold": input,
+	}
+
+	out := struct {
+		FulfillmentOrderHoldResult model.FulfillmentOrderHoldPayload `json:"fulfillmentOrderHold"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.FulfillmentOrderHoldResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return &out.FulfillmentOrderHoldResult, nil
+}
+
+// ReleaseHold releases the given holds of the fulfillment order, or all of them if none are given.
+func (s *FulfillmentOrderServiceOp) ReleaseHold(ctx context.Context, id string, holdIDs []string) (*model.FulfillmentOrder, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	if len(holdIDs) > 0 {
+		vars["holdIds"] = holdIDs
+	}
+
+	return mutateFulfillmentOrder[model.FulfillmentOrderReleaseHoldUserError](ctx, s, "fulfillmentOrderReleaseHold",
+		"$id: ID!, $holdIds: [ID!]", "id: $id, holdIds: $holdIds", "code", vars)
+}
+
+// Move moves the fulfillment order, or the given line items of it, to the new location.
+func (s *FulfillmentOrderServiceOp) Move(ctx context.Context, id string, newLocationID string, lineItems []model.FulfillmentOrderLineItemInput) (*model.FulfillmentOrderMovePayload, error) {
+	m := fulfillmentOrderMutation("fulfillmentOrderMove",
+		"$id: ID!, $newLocationId: ID!, $fulfillmentOrderLineItems: [FulfillmentOrderLineItemInput!]",
+		"id: $id, newLocationId: $newLocationId, fulfillmentOrderLineItems: $fulfillmentOrderLineItems",
+		fmt.Sprintf(`
+			movedFulfillmentOrder{
+				%[1]s
+			}
+			originalFulfillmentOrder{
+				%[1]s
+			}
+			remainingFulfillmentOrder{
+				%[1]s
+			}
+		`, fulfillmentOrderQuery),
+		"")
+
+	vars := map[string]interface{}{
+		"id":            id,
+		"newLocationId": newLocationID,
+	}
+	if len(lineItems) > 0 {
+		vars["fulfillmentOrderLineItems"] = lineItems
+	}
+
+	out := struct {
+		FulfillmentOrderMoveResult model.FulfillmentOrderMovePayload `json:"fulfillmentOrderMove"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.FulfillmentOrderMoveResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return &out.FulfillmentOrderMoveResult, nil
+}
+
+// Split splits the given line items of the fulfillment orders into new fulfillment orders.
+func (s *FulfillmentOrderServiceOp) Split(ctx context.Context, splits []model.FulfillmentOrderSplitInput) ([]model.FulfillmentOrderSplitResult, error) {
+	m := fulfillmentOrderMutation("fulfillmentOrderSplit",
+		"$fulfillmentOrderSplits: [FulfillmentOrderSplitInput!]!",
+		"fulfillmentOrderSplits: $fulfillmentOrderSplits",
+		fmt.Sprintf(`
+			fulfillmentOrderSplits{
+				fulfillmentOrder{
+					%[1]s
+				}
+				remainingFulfillmentOrder{
+					%[1]s
+				}
+				replacementFulfillmentOrder{
+					%[1]s
+				}
+			}
+		`, fulfillmentOrderQuery),
+		"code")
+
+	vars := map[string]interface{}{
+		"fulfillmentOrderSplits": splits,
+	}
+
+	out := struct {
+		FulfillmentOrderSplitResult model.FulfillmentOrderSplitPayload `json:"fulfillmentOrderSplit"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.FulfillmentOrderSplitResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return out.FulfillmentOrderSplitResult.FulfillmentOrderSplits, nil
+}
+
+// Merge merges the fulfillment orders of each merge intent into a single fulfillment order.
+func (s *FulfillmentOrderServiceOp) Merge(ctx context.Context, merges []model.FulfillmentOrderMergeInput) ([]model.FulfillmentOrderMergeResult, error) {
+	m := fulfillmentOrderMutation("fulfillmentOrderMerge",
+		"$fulfillmentOrderMergeInputs: [FulfillmentOrderMergeInput!]!",
+		"fulfillmentOrderMergeInputs: $fulfillmentOrderMergeInputs",
+		fmt.Sprintf(`
+			fulfillmentOrderMerges{
+				fulfillmentOrder{
+					%s
+				}
+			}
+		`, fulfillmentOrderQuery),
+		"code")
+
+	vars := map[string]interface{}{
+		"fulfillmentOrderMergeInputs": merges,
+	}
+
+	out := struct {
+		FulfillmentOrderMergeResult model.FulfillmentOrderMergePayload `json:"fulfillmentOrderMerge"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.FulfillmentOrderMergeResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return out.FulfillmentOrderMergeResult.FulfillmentOrderMerges, nil
+}
+
+// Reschedule sets the time the scheduled fulfillment order becomes ready for fulfillment.
+func (s *FulfillmentOrderServiceOp) Reschedule(ctx context.Context, id string, fulfillAt time.Time) (*model.FulfillmentOrder, error) {
+	return mutateFulfillmentOrder[model.FulfillmentOrderRescheduleUserError](ctx, s, "fulfillmentOrderReschedule",
+		"$id: ID!, $fulfillAt: DateTime!", "id: $id, fulfillAt: $fulfillAt", "code", map[string]interface{}{
+			"id":        id,
+			"fulfillAt": fulfillAt.UTC().Format(time.RFC3339),
+		})
+}
+
+// SubmitFulfillmentRequest requests the fulfillment service to fulfill the fulfillment order.
+func (s *FulfillmentOrderServiceOp) SubmitFulfillmentRequest(ctx context.Context, id string, message string, notifyCustomer bool) (*model.FulfillmentOrderSubmitFulfillmentRequestPayload, error) {
+	m := fulfillmentOrderMutation("fulfillmentOrderSubmitFulfillmentRequest",
+		"$id: ID!, $message: String, $notifyCustomer: Boolean",
+		"id: $id, message: $message, notifyCustomer: $notifyCustomer",
+		fmt.Sprintf(`
+			originalFulfillmentOrder{
+				%[1]s
+			}
+			submittedFulfillmentOrder{
+				%[1]s
+			}
+			unsubmittedFulfillmentOrder{
+				%[1]s
+			}
+		`, fulfillmentOrderQuery),
+		"")
+
+	vars := requestMessageVariables(id, message)
+	vars["notifyCustomer"] = notifyCustomer
+
+	out := struct {
+		FulfillmentOrderSubmitFulfillmentRequestResult model.FulfillmentOrderSubmitFulfillmentRequestPayload `json:"fulfillmentOrderSubmitFulfillmentRequest"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.FulfillmentOrderSubmitFulfillmentRequestResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return &out.FulfillmentOrderSubmitFulfillmentRequestResult, nil
+}
+
+func (s *FulfillmentOrderServiceOp) AcceptFulfillmentRequest(ctx context.Context, id string, message string) (*model.FulfillmentOrder, error) {
+	return mutateFulfillmentOrder[model.UserError](ctx, s, "fulfillmentOrderAcceptFulfillmentRequest",
+		"$id: ID!, $message: String", "id: $id, message: $message", "", requestMessageVariables(id, message))
+}
+
+func (s *FulfillmentOrderServiceOp) RejectFulfillmentRequest(ctx context.Context, id string, message string) (*model.FulfillmentOrder, error) {
+	return mutateFulfillmentOrder[model.UserError](ctx, s, "fulfillmentOrderRejectFulfillmentRequest",
+		"$id: ID!, $message: String", "id: $id, message: $message", "", requestMessageVariables(id, message))
+}
+
+// SubmitCancellationRequest requests the fulfillment service to cancel the fulfillment order it accepted.
+func (s *FulfillmentOrderServiceOp) SubmitCancellationRequest(ctx context.Context, id string, message string) (*model.FulfillmentOrder, error) {
+	return mutateFulfillmentOrder[model.UserError](ctx, s, "fulfillmentOrderSubmitCancellationRequest",
+		"$id: ID!, $message: String", "id: $id, message: $message", "", requestMessageVariables(id, message))
+}
+
+func (s *FulfillmentOrderServiceOp) AcceptCancellationRequest(ctx context.Context, id string, message string) (*model.FulfillmentOrder, error) {
+	return mutateFulfillmentOrder[model.UserError](ctx, s, "fulfillmentOrderAcceptCancellationRequest",
+		"$id: ID!, $message: String", "id: $id, message: $message", "", requestMessageVariables(id, message))
+}
+
+func (s *FulfillmentOrderServiceOp) RejectCancellationRequest(ctx context.Context, id string, message string) (*model.FulfillmentOrder, error) {
+	return mutateFulfillmentOrder[model.UserError](ctx, s, "fulfillmentOrderRejectCancellationRequest",
+		"$id: ID!, $message: String", "id: $id, message: $message", "", requestMessageVariables(id, message))
+}
+
+// requestMessageVariables returns the variables of the fulfillment and cancellation request mutations, omitting an empty message.
+func requestMessageVariables(id string, message string) map[string]interface{} {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	if message != "" {
+		vars["message"] = message
+	}
+	return vars
+}
+
+// fulfillmentOrderMutation returns the mutation document selecting the payload fields and the user errors.
+func fulfillmentOrderMutation(name string, definitions string, arguments string, payload string, userErrorFields string) string {
+	return fmt.Sprintf(`
+		mutation %[1]s(%[2]s) {
+			%[1]s(%[3]s){
+				%[4]s
+				userErrors{
+					field
+					message
+					%[5]s
+				}
+			}
+		}
+
+		%[6]s
+	`, name, definitions, arguments, payload, userErrorFields, fulfillmentOrderLineItemFragment)
+}
+
+// mutateFulfillmentOrder runs the mutation changing a single fulfillment order and returns the changed fulfillment order.
+func mutateFulfillmentOrder[E model.DisplayableError](ctx context.Context, s *FulfillmentOrderServiceOp, name string, definitions string, arguments string, userErrorFields string, vars map[string]interface{}) (*model.FulfillmentOrder, error) {
+	m := fulfillmentOrderMutation(name, definitions, arguments, fmt.Sprintf(`
+		fulfillmentOrder{
+			%s
+		}
+	`, fulfillmentOrderQuery), userErrorFields)
+
+	out := map[string]fulfillmentOrderPayload[E]{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	res := out[name]
+	if err := newUserErrorsError(res.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return res.FulfillmentOrder, nil
+}
@@ -0,0 +1,227 @@
+package shopify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFulfillmentOrderGet(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1","status":"OPEN","lineItems":{"edges":[{"node":{"id":"gid://shopify/FulfillmentOrderLineItem/1"},"cursor":"a"}],"pageInfo":{"hasNextPage":true,"endCursor":"a"}}}}`,
+		`{"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1","status":"OPEN","lineItems":{"edges":[{"node":{"id":"gid://shopify/FulfillmentOrderLineItem/2"},"cursor":"b"}],"pageInfo":{"hasNextPage":false,"endCursor":"b"}}}}`,
+	)
+	c := srv.client(t)
+
+	fo, err := c.FulfillmentOrder.Get(context.Background(), "gid://shopify/FulfillmentOrder/1")
+	require.NoError(t, err)
+	assert.Equal(t, model.FulfillmentOrderStatusOpen, fo.Status)
+	require.Len(t, fo.LineItems.Edges, 2)
+	assert.Equal(t, "gid://shopify/FulfillmentOrderLineItem/2", fo.LineItems.Edges[1].Node.ID)
+
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/FulfillmentOrder/1", "first": float64(fulfillmentOrderLineItemsPageSize)}, srv.request(t, 0).Variables)
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/FulfillmentOrder/1", "first": float64(fulfillmentOrderLineItemsPageSize), "after": "a"}, srv.request(t, 1).Variables)
+}
+
+func TestFulfillmentOrderListByOrder(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"order":{"fulfillmentOrders":{"edges":[
+			{"node":{"id":"gid://shopify/FulfillmentOrder/1","lineItems":{"edges":[{"node":{"id":"gid://shopify/FulfillmentOrderLineItem/1"},"cursor":"a"}],"pageInfo":{"hasNextPage":true,"endCursor":"a"}}},"cursor":"fo1"},
+			{"node":{"id":"gid://shopify/FulfillmentOrder/2","lineItems":{"edges":[{"node":{"id":"gid://shopify/FulfillmentOrderLineItem/3"},"cursor":"c"}],"pageInfo":{"hasNextPage":false,"endCursor":"c"}}},"cursor":"fo2"}
+		],"pageInfo":{"hasNextPage":false,"endCursor":"fo2"}}}}`,
+		`{"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1","lineItems":{"edges":[{"node":{"id":"gid://shopify/FulfillmentOrderLineItem/2"},"cursor":"b"}],"pageInfo":{"hasNextPage":false,"endCursor":"b"}}}}`,
+	)
+	c := srv.client(t)
+
+	fos, err := c.FulfillmentOrder.ListByOrder(context.Background(), "gid://shopify/Order/1")
+	require.NoError(t, err)
+	require.Len(t, fos, 2)
+	require.Len(t, fos[0].LineItems.Edges, 2, "the line items past the first page are paged through")
+	assert.Equal(t, "gid://shopify/FulfillmentOrderLineItem/1", fos[0].LineItems.Edges[0].Node.ID)
+	assert.Equal(t, "gid://shopify/FulfillmentOrderLineItem/2", fos[0].LineItems.Edges[1].Node.ID)
+	require.Len(t, fos[1].LineItems.Edges, 1)
+
+	list := srv.request(t, 0)
+	assert.Contains(t, list.Query, "query orderFulfillmentOrders($id: ID!, $first: Int, $after: String)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/Order/1", "first": float64(fulfillmentOrdersPageSize)}, list.Variables)
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/FulfillmentOrder/1", "first": float64(fulfillmentOrderLineItemsPageSize), "after": "a"}, srv.request(t, 1).Variables)
+	assert.Len(t, srv.requests, 2, "the complete line items aren't queried again")
+}
+
+func TestFulfillmentOrderHold(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"fulfillmentOrderHold":{"fulfillmentHold":{"id":"gid://shopify/FulfillmentHold/1","reason":"AWAITING_PAYMENT"},"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1","status":"ON_HOLD"}}}`,
+		`{"fulfillmentOrderReleaseHold":{"userErrors":[{"field":["id"],"message":"Fulfillment order not found","code":"FULFILLMENT_ORDER_NOT_FOUND"}]}}`,
+	)
+	c := srv.client(t)
+
+	held, err := c.FulfillmentOrder.Hold(context.Background(), "gid://shopify/FulfillmentOrder/1", model.FulfillmentOrderHoldInput{
+		Reason:                    model.FulfillmentHoldReasonAwaitingPayment,
+		FulfillmentOrderLineItems: []model.FulfillmentOrderLineItemInput{{ID: "gid://shopify/FulfillmentOrderLineItem/1", Quantity: 1}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.FulfillmentOrderStatusOnHold, held.FulfillmentOrder.Status)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation fulfillmentOrderHold($id: ID!, $fulfillmentHold: FulfillmentOrderHoldInput!)")
+	assert.Equal(t, map[string]interface{}{
+		"id": "gid://shopify/FulfillmentOrder/1",
+		"fulfillmentHold": map[string]interface{}{
+			"reason":                    "AWAITING_PAYMENT",
+			"fulfillmentOrderLineItems": []interface{}{map[string]interface{}{"id": "gid://shopify/FulfillmentOrderLineItem/1", "quantity": float64(1)}},
+		},
+	}, req.Variables)
+
+	_, err = c.FulfillmentOrder.ReleaseHold(context.Background(), "gid://shopify/FulfillmentOrder/1", nil)
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, "FULFILLMENT_ORDER_NOT_FOUND", userErrs.Errors[0].Code)
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/FulfillmentOrder/1"}, srv.last(t).Variables, "all the holds are released")
+}
+
+func TestFulfillmentOrderMove(t *testing.T) {
+	srv := newGraphQLServer(t, `{"fulfillmentOrderMove":{
+		"movedFulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/2","assignedLocation":{"location":{"id":"gid://shopify/Location/2"}}},
+		"originalFulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1","status":"OPEN"}
+	}}`)
+	c := srv.client(t)
+
+	moved, err := c.FulfillmentOrder.Move(context.Background(), "gid://shopify/FulfillmentOrder/1", "gid://shopify/Location/2",
+		[]model.FulfillmentOrderLineItemInput{{ID: "gid://shopify/FulfillmentOrderLineItem/1", Quantity: 2}})
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/FulfillmentOrder/2", moved.MovedFulfillmentOrder.ID)
+	assert.Equal(t, "gid://shopify/Location/2", moved.MovedFulfillmentOrder.AssignedLocation.Location.ID)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation fulfillmentOrderMove($id: ID!, $newLocationId: ID!, $fulfillmentOrderLineItems: [FulfillmentOrderLineItemInput!])")
+	assert.Equal(t, map[string]interface{}{
+		"id":                        "gid://shopify/FulfillmentOrder/1",
+		"newLocationId":             "gid://shopify/Location/2",
+		"fulfillmentOrderLineItems": []interface{}{map[string]interface{}{"id": "gid://shopify/FulfillmentOrderLineItem/1", "quantity": float64(2)}},
+	}, req.Variables)
+
+	_, err = c.FulfillmentOrder.Move(context.Background(), "gid://shopify/FulfillmentOrder/1", "gid://shopify/Location/2", nil)
+	require.NoError(t, err)
+	assert.NotContains(t, srv.last(t).Variables, "fulfillmentOrderLineItems", "the whole fulfillment order is moved")
+}
+
+func TestFulfillmentOrderSplit(t *testing.T) {
+	srv := newGraphQLServer(t, `{"fulfillmentOrderSplit":{"fulfillmentOrderSplits":[{
+		"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1"},
+		"remainingFulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1"},
+		"replacementFulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/3"}
+	}]}}`)
+	c := srv.client(t)
+
+	splits, err := c.FulfillmentOrder.Split(context.Background(), []model.FulfillmentOrderSplitInput{{
+		FulfillmentOrderID:        "gid://shopify/FulfillmentOrder/1",
+		FulfillmentOrderLineItems: []model.FulfillmentOrderLineItemInput{{ID: "gid://shopify/FulfillmentOrderLineItem/1", Quantity: 1}},
+	}})
+	require.NoError(t, err)
+	require.Len(t, splits, 1)
+	assert.Equal(t, "gid://shopify/FulfillmentOrder/3", splits[0].ReplacementFulfillmentOrder.ID)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation fulfillmentOrderSplit($fulfillmentOrderSplits: [FulfillmentOrderSplitInput!]!)")
+	assert.Equal(t, map[string]interface{}{
+		"fulfillmentOrderSplits": []interface{}{map[string]interface{}{
+			"fulfillmentOrderId":        "gid://shopify/FulfillmentOrder/1",
+			"fulfillmentOrderLineItems": []interface{}{map[string]interface{}{"id": "gid://shopify/FulfillmentOrderLineItem/1", "quantity": float64(1)}},
+		}},
+	}, req.Variables)
+}
+
+func TestFulfillmentOrderMerge(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"fulfillmentOrderMerge":{"fulfillmentOrderMerges":[{"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1"}}]}}`,
+		`{"fulfillmentOrderMerge":{"userErrors":[{"field":["fulfillmentOrderMergeInputs"],"message":"Fulfillment orders can't be merged","code":"GREATER_THAN"}]}}`,
+	)
+	c := srv.client(t)
+
+	merges := []model.FulfillmentOrderMergeInput{{MergeIntents: []model.FulfillmentOrderMergeInputMergeIntent{
+		{FulfillmentOrderID: "gid://shopify/FulfillmentOrder/1"},
+		{FulfillmentOrderID: "gid://shopify/FulfillmentOrder/2"},
+	}}}
+	merged, err := c.FulfillmentOrder.Merge(context.Background(), merges)
+	require.NoError(t, err)
+	require.Len(t, merged, 1)
+	assert.Equal(t, "gid://shopify/FulfillmentOrder/1", merged[0].FulfillmentOrder.ID)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation fulfillmentOrderMerge($fulfillmentOrderMergeInputs: [FulfillmentOrderMergeInput!]!)")
+	assert.Equal(t, map[string]interface{}{
+		"fulfillmentOrderMergeInputs": []interface{}{map[string]interface{}{"mergeIntents": []interface{}{
+			map[string]interface{}{"fulfillmentOrderId": "gid://shopify/FulfillmentOrder/1"},
+			map[string]interface{}{"fulfillmentOrderId": "gid://shopify/FulfillmentOrder/2"},
+		}}},
+	}, req.Variables)
+
+	_, err = c.FulfillmentOrder.Merge(context.Background(), merges)
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, "GREATER_THAN", userErrs.Errors[0].Code)
+}
+
+func TestFulfillmentOrderFulfillmentRequest(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"fulfillmentOrderSubmitFulfillmentRequest":{"submittedFulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1","requestStatus":"SUBMITTED"}}}`,
+		`{"fulfillmentOrderAcceptFulfillmentRequest":{"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1","requestStatus":"ACCEPTED"}}}`,
+		`{"fulfillmentOrderRejectFulfillmentRequest":{"userErrors":[{"field":["id"],"message":"Fulfillment order isn't submitted"}]}}`,
+	)
+	c := srv.client(t)
+	ctx := context.Background()
+
+	submitted, err := c.FulfillmentOrder.SubmitFulfillmentRequest(ctx, "gid://shopify/FulfillmentOrder/1", "Ship today", true)
+	require.NoError(t, err)
+	assert.Equal(t, model.FulfillmentOrderRequestStatusSubmitted, submitted.SubmittedFulfillmentOrder.RequestStatus)
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation fulfillmentOrderSubmitFulfillmentRequest($id: ID!, $message: String, $notifyCustomer: Boolean)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/FulfillmentOrder/1", "message": "Ship today", "notifyCustomer": true}, req.Variables)
+
+	accepted, err := c.FulfillmentOrder.AcceptFulfillmentRequest(ctx, "gid://shopify/FulfillmentOrder/1", "")
+	require.NoError(t, err)
+	assert.Equal(t, model.FulfillmentOrderRequestStatusAccepted, accepted.RequestStatus)
+	req = srv.last(t)
+	assert.Contains(t, req.Query, "fulfillmentOrderAcceptFulfillmentRequest(id: $id, message: $message)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/FulfillmentOrder/1"}, req.Variables, "the empty message is left out")
+
+	_, err = c.FulfillmentOrder.RejectFulfillmentRequest(ctx, "gid://shopify/FulfillmentOrder/1", "Out of stock")
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, "Fulfillment order isn't submitted", userErrs.Errors[0].Message)
+	req = srv.last(t)
+	assert.Contains(t, req.Query, "fulfillmentOrderRejectFulfillmentRequest(id: $id, message: $message)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/FulfillmentOrder/1", "message": "Out of stock"}, req.Variables)
+}
+
+func TestFulfillmentOrderCancellationRequest(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"fulfillmentOrderSubmitCancellationRequest":{"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1","requestStatus":"CANCELLATION_REQUESTED"}}}`,
+		`{"fulfillmentOrderRejectCancellationRequest":{"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1","requestStatus":"CANCELLATION_REJECTED"}}}`,
+		`{"fulfillmentOrderAcceptCancellationRequest":{"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1","requestStatus":"CANCELLATION_ACCEPTED"}}}`,
+	)
+	c := srv.client(t)
+	ctx := context.Background()
+
+	fo, err := c.FulfillmentOrder.SubmitCancellationRequest(ctx, "gid://shopify/FulfillmentOrder/1", "Customer changed their mind")
+	require.NoError(t, err)
+	assert.Equal(t, model.FulfillmentOrderRequestStatusCancellationRequested, fo.RequestStatus)
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation fulfillmentOrderSubmitCancellationRequest($id: ID!, $message: String)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/FulfillmentOrder/1", "message": "Customer changed their mind"}, req.Variables)
+
+	fo, err = c.FulfillmentOrder.RejectCancellationRequest(ctx, "gid://shopify/FulfillmentOrder/1", "Already shipped")
+	require.NoError(t, err)
+	assert.Equal(t, model.FulfillmentOrderRequestStatusCancellationRejected, fo.RequestStatus)
+	assert.Contains(t, srv.last(t).Query, "fulfillmentOrderRejectCancellationRequest(id: $id, message: $message)")
+
+	fo, err = c.FulfillmentOrder.AcceptCancellationRequest(ctx, "gid://shopify/FulfillmentOrder/1", "")
+	require.NoError(t, err)
+	assert.Equal(t, model.FulfillmentOrderRequestStatusCancellationAccepted, fo.RequestStatus)
+	req = srv.last(t)
+	assert.Contains(t, req.Query, "fulfillmentOrderAcceptCancellationRequest(id: $id, message: $message)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/FulfillmentOrder/1"}, req.Variables)
+}
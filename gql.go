@@ -0,0 +1,123 @@
+package shopify
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"time"
+
+	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
+	"github.com/r0busta/graphql"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	operationNameRegex = regexp.MustCompile(`^\s*(?:query|mutation)\s+(\w+)`)
+	fieldNameRegex     = regexp.MustCompile(`^\w+`)
+)
+
+// gqlClient decorates the GraphQL client used by the services, converting its errors into the typed errors,
+// logging and tracing every operation.
+type gqlClient struct {
+	gql    graphql.GraphQL
+	client *Client
+}
+
+var _ graphql.GraphQL = &gqlClient{}
+
+func (c *gqlClient) QueryString(ctx context.Context, q string, variables map[string]interface{}, v interface{}) error {
+	ctx, done := c.start(ctx, stringOperationName(q))
+	return done(c.gql.QueryString(ctx, q, variables, v), v)
+}
+
+func (c *gqlClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	ctx, done := c.start(ctx, structOperationName(q))
+	return done(c.gql.Query(ctx, q, variables), q)
+}
+
+func (c *gqlClient) Mutate(ctx context.Context, m interface{}, variables map[string]interface{}) error {
+	ctx, done := c.start(ctx, structOperationName(m))
+	return done(c.gql.Mutate(ctx, m, variables), m)
+}
+
+func (c *gqlClient) MutateString(ctx context.Context, m string, variables map[string]interface{}, v interface{}) error {
+	ctx, done := c.start(ctx, stringOperationName(m))
+	return done(c.gql.MutateString(ctx, m, variables, v), v)
+}
+
+// start starts tracing the operation and returns the function that converts the error of the operation,
+// logs it and records its telemetry.
+func (c *gqlClient) start(ctx context.Context, operation string) (context.Context, func(err error, out interface{}) error) {
+	start := time.Now()
+	ctx, span := c.client.startSpan(ctx, spanName(operation), attribute.String("graphql.operation.name", operation))
+	ctx, info := withResponseInfo(ctx)
+
+	return ctx, func(err error, out interface{}) error {
+		err = wrapGraphQLError(err, info)
+		observeCost(ctx, info.Cost)
+		c.client.recordOperation(ctx, span, operation, start, info, out, err)
+		c.log(ctx, operation, info, err)
+		return err
+	}
+}
+
+func (c *gqlClient) log(ctx context.Context, operation string, info *graphqlclient.ResponseInfo, err error) {
+	args := []any{"operation", operation}
+	if info.Cost != nil {
+		args = append(args, "requested_cost", info.Cost.RequestedQueryCost, "currently_available", info.Cost.ThrottleStatus.CurrentlyAvailable)
+		if info.Cost.ActualQueryCost != nil {
+			args = append(args, "actual_cost", *info.Cost.ActualQueryCost)
+		}
+	}
+	if info.Retries > 0 {
+		args = append(args, "retries", info.Retries)
+	}
+
+	if err != nil {
+		c.client.log(ctx, slog.LevelDebug, "GraphQL operation failed", append(args, "error", err)...)
+		return
+	}
+
+	c.client.log(ctx, slog.LevelDebug, "GraphQL operation done", args...)
+}
+
+func withResponseInfo(ctx context.Context) (context.Context, *graphqlclient.ResponseInfo) {
+	info := &graphqlclient.ResponseInfo{}
+	return graphqlclient.WithResponseInfo(ctx, info), info
+}
+
+func spanName(operation string) string {
+	if operation == "" {
+		return "shopify.graphql"
+	}
+	return "shopify.graphql " + operation
+}
+
+// stringOperationName returns the name of the operation in the query document, e.g. `product` for `query product($id: ID!)`.
+func stringOperationName(q string) string {
+	m := operationNameRegex.FindStringSubmatch(q)
+	if len(m) != 2 {
+		return ""
+	}
+	return m[1]
+}
+
+// structOperationName returns the root field name of the query struct, e.g. `productCreate`.
+func structOperationName(q interface{}) string {
+	t := reflect.TypeOf(q)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || t.NumField() == 0 {
+		return ""
+	}
+
+	f := t.Field(0)
+	if tag := f.Tag.Get("graphql"); tag != "" {
+		if m := fieldNameRegex.FindString(tag); m != "" {
+			return m
+		}
+	}
+	return f.Name
+}
@@ -1,8 +1,14 @@
 package graphqlclient
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/r0busta/graphql"
 )
@@ -35,6 +41,35 @@ func WithToken(token string) Option {
 	}
 }
 
+// WithRateLimiter optionally sets the limiter pacing the requests. Pass nil to disable rate limiting.
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(t *transport) {
+		t.rateLimiter = limiter
+	}
+}
+
+// WithHTTPClient optionally sets the HTTP client the requests are sent with.
+// Its transport, or http.DefaultTransport if not set, is wrapped by the Shopify transport.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(t *transport) {
+		t.httpClient = httpClient
+	}
+}
+
+// WithTransport optionally sets the round tripper the requests are sent with, e.g. for proxies, mTLS or tracing.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(t *transport) {
+		t.base = rt
+	}
+}
+
+// WithBaseURL optionally overrides the `https://<shop>.myshopify.com` part of the API endpoint, e.g. to use a fake server in tests.
+func WithBaseURL(baseURL string) Option {
+	return func(t *transport) {
+		t.baseURL = baseURL
+	}
+}
+
 // WithPrivateAppAuth optionally sets private app credentials (API key and access token).
 func WithPrivateAppAuth(apiKey string, accessToken string) Option {
 	return func(t *transport) {
@@ -47,6 +82,12 @@ type transport struct {
 	accessToken string
 	apiKey      string
 	apiBasePath string
+	rateLimiter RateLimiter
+	retryPolicy *RetryPolicy
+
+	baseURL    string
+	base       http.RoundTripper
+	httpClient *http.Client
 }
 
 func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -59,28 +100,156 @@ func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		req.Header.Set(shopifyAccessTokenHeader, t.accessToken)
 	}
 
-	return http.DefaultTransport.RoundTrip(req)
+	if t.retryPolicy == nil {
+		resp, _, err := t.send(req)
+		return resp, err
+	}
+
+	return t.sendWithRetries(req)
+}
+
+// send sends a single request, waiting for the rate limiter first and reporting the response cost to it afterwards.
+func (t *transport) send(req *http.Request) (*http.Response, *response, error) {
+	if t.rateLimiter != nil {
+		if err := t.rateLimiter.Wait(req.Context()); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := responseInfoFromContext(req.Context())
+	if info != nil {
+		info.StatusCode = resp.StatusCode
+	}
+
+	out, err := readResponse(resp)
+	if errors.Is(err, errMalformedResponse) && !isSuccessStatus(resp.StatusCode) {
+		// Not a GraphQL response, e.g. an HTML error page of a proxy. It's passed through as is
+		// for the status code to be retried or reported by the GraphQL client.
+		return resp, nil, nil
+	}
+	if err != nil {
+		resp.Body.Close()
+		return nil, nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if info != nil {
+		info.Errors = out.Errors
+		info.Cost = out.Extensions.Cost
+	}
+
+	if out.Extensions.Cost != nil && t.rateLimiter != nil {
+		t.rateLimiter.Update(*out.Extensions.Cost)
+	}
+
+	return resp, out, nil
 }
 
 // NewClient creates a new client (in fact, just a simple wrapper for a graphql.Client).
 func NewClient(shopName string, opts ...Option) *graphql.Client {
 	transport := &transport{
 		apiBasePath: defaultAPIBasePath,
+		rateLimiter: NewLeakyBucket(),
 	}
 
 	for _, opt := range opts {
 		opt(transport)
 	}
 
-	httpClient := &http.Client{
-		Transport: transport,
+	httpClient := &http.Client{}
+	if transport.httpClient != nil {
+		*httpClient = *transport.httpClient
+		if transport.base == nil {
+			transport.base = transport.httpClient.Transport
+		}
 	}
+	httpClient.Transport = transport
 
 	url := buildAPIEndpoint(shopName, transport.apiBasePath)
+	if transport.baseURL != "" {
+		url = buildAPIEndpointWithBaseURL(transport.baseURL, transport.apiBasePath)
+	}
 
 	return graphql.NewClient(url, httpClient)
 }
 
+// Error is a GraphQL error as returned by Shopify.
+type Error struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Code returns the `extensions.code` of the error, e.g. THROTTLED or ACCESS_DENIED.
+func (e Error) Code() string {
+	code, _ := e.Extensions["code"].(string)
+	return code
+}
+
+// ResponseInfo holds the details of the last response that the graphql.Client doesn't expose.
+type ResponseInfo struct {
+	StatusCode int
+	Errors     []Error
+	Cost       *QueryCost
+	Retries    int
+}
+
+type responseInfoKey struct{}
+
+// WithResponseInfo returns a context that makes the client record the response details into info.
+func WithResponseInfo(ctx context.Context, info *ResponseInfo) context.Context {
+	return context.WithValue(ctx, responseInfoKey{}, info)
+}
+
+func responseInfoFromContext(ctx context.Context) *ResponseInfo {
+	info, _ := ctx.Value(responseInfoKey{}).(*ResponseInfo)
+	return info
+}
+
+type response struct {
+	Errors     []Error `json:"errors"`
+	Extensions struct {
+		Cost *QueryCost `json:"cost"`
+	} `json:"extensions"`
+}
+
+var errMalformedResponse = errors.New("malformed GraphQL response")
+
+// readResponse parses the errors and extensions of a GraphQL response, leaving the response body intact.
+// It returns errMalformedResponse if the body isn't JSON.
+func readResponse(resp *http.Response) (*response, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	out := &response{}
+	if err := json.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("%w: %w", errMalformedResponse, err)
+	}
+
+	return out, nil
+}
+
+func isSuccessStatus(code int) bool {
+	return code >= 200 && code < 300
+}
+
 func buildAPIEndpoint(shopName string, apiPathPrefix string) string {
 	return fmt.Sprintf("%s://%s.%s/%s/%s", defaultAPIProtocol, shopName, shopifyBaseDomain, apiPathPrefix, defaultAPIEndpoint)
 }
+
+func buildAPIEndpointWithBaseURL(baseURL string, apiPathPrefix string) string {
+	return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(baseURL, "/"), apiPathPrefix, defaultAPIEndpoint)
+}
@@ -33,3 +33,11 @@ func TestBuildAPIEndpoint(t *testing.T) {
 		t.Errorf("buildAPIEndpoint = %s, expected %s", actual, expected)
 	}
 }
+
+func TestBuildAPIEndpointWithBaseURL(t *testing.T) {
+	expected := "http://127.0.0.1:8080/admin/api/2025-01/graphql.json"
+	actual := buildAPIEndpointWithBaseURL("http://127.0.0.1:8080/", "admin/api/2025-01")
+	if actual != expected {
+		t.Errorf("buildAPIEndpointWithBaseURL = %s, expected %s", actual, expected)
+	}
+}
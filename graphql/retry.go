@@ -0,0 +1,169 @@
+package graphqlclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+
+	throttledErrorCode = "THROTTLED"
+)
+
+// RetryPolicy configures retrying of throttled and transiently failed requests.
+// Zero fields are set to their defaults.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry, doubled on every subsequent retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+}
+
+// WithRetryPolicy optionally enables retrying of requests failed with HTTP 429 or 5xx status codes,
+// network resets and THROTTLED GraphQL errors.
+// Mutations are never retried unless their context is marked with WithIdempotent.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(t *transport) {
+		if policy.MaxRetries == 0 {
+			policy.MaxRetries = defaultMaxRetries
+		}
+		if policy.MinBackoff == 0 {
+			policy.MinBackoff = defaultMinBackoff
+		}
+		if policy.MaxBackoff == 0 {
+			policy.MaxBackoff = defaultMaxBackoff
+		}
+
+		t.retryPolicy = &policy
+	}
+}
+
+type idempotentKey struct{}
+
+// WithIdempotent marks the requests made with the returned context as safe to retry, even if they are mutations.
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+func isIdempotent(ctx context.Context) bool {
+	v, _ := ctx.Value(idempotentKey{}).(bool)
+	return v
+}
+
+func (t *transport) sendWithRetries(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	retryable := isIdempotent(ctx) || !isMutation(body)
+
+	for attempt := 0; ; attempt++ {
+		r := req.Clone(ctx)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		resp, out, err := t.send(r)
+		if !retryable || attempt >= t.retryPolicy.MaxRetries || !shouldRetry(resp, out, err) {
+			return resp, err
+		}
+
+		if info := responseInfoFromContext(ctx); info != nil {
+			info.Retries++
+		}
+
+		delay := t.retryPolicy.backoff(attempt)
+		if resp != nil {
+			if d, ok := retryAfter(resp); ok {
+				delay = d
+			}
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the exponential delay with jitter for the given zero-based attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff << attempt
+	if d <= 0 || d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	return d/2 + rand.N(d/2+1)
+}
+
+func shouldRetry(resp *http.Response, out *response, err error) bool {
+	if err != nil {
+		return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return true
+	}
+
+	if out != nil {
+		for _, e := range out.Errors {
+			if e.Code() == throttledErrorCode {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseFloat(v, 64); err == nil {
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t), true
+	}
+
+	return 0, false
+}
+
+func isMutation(body []byte) bool {
+	var in struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &in); err != nil {
+		return true
+	}
+
+	return strings.HasPrefix(strings.TrimSpace(in.Query), "mutation")
+}
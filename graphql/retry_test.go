@@ -0,0 +1,148 @@
+package graphqlclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestRetryTransport() *transport {
+	t := &transport{}
+	WithRetryPolicy(RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})(t)
+	return t
+}
+
+func TestRetryOnServerError(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{"query":"query { shop { id } }"}`))
+	resp, err := newTestRetryTransport().RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status code = %d, expected %d", resp.StatusCode, http.StatusOK)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, expected 2", calls)
+	}
+}
+
+func TestRetryOnThrottledError(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Throttled","extensions":{"code":"THROTTLED"}}]}`))
+	}))
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{"query":"query { shop { id } }"}`))
+	resp, err := newTestRetryTransport().RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if calls != 3 {
+		t.Errorf("calls = %d, expected 3", calls)
+	}
+}
+
+func TestNoRetryOnMutation(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected int32
+	}{{
+		name:     "mutation",
+		ctx:      context.Background(),
+		expected: 1,
+	}, {
+		name:     "idempotent mutation",
+		ctx:      WithIdempotent(context.Background()),
+		expected: 3,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+			defer srv.Close()
+
+			req, _ := http.NewRequestWithContext(tt.ctx, http.MethodPost, srv.URL, strings.NewReader(`{"query":"mutation { tagsAdd { userErrors { message } } }"}`))
+			resp, err := newTestRetryTransport().RoundTrip(req)
+			if err != nil {
+				t.Fatalf("RoundTrip error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if calls != tt.expected {
+				t.Errorf("calls = %d, expected %d", calls, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRetryOnTruncatedResponse(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Content-Length", "100")
+			_, _ = w.Write([]byte(`{"data":`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{"query":"query { shop { id } }"}`))
+	resp, err := newTestRetryTransport().RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if calls != 2 {
+		t.Errorf("calls = %d, expected 2", calls)
+	}
+}
+
+func TestMalformedResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html>OK</html>`))
+	}))
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{"query":"query { shop { id } }"}`))
+	_, err := (&transport{}).RoundTrip(req)
+	if !errors.Is(err, errMalformedResponse) {
+		t.Errorf("RoundTrip error = %v, expected %v", err, errMalformedResponse)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "2.0")
+
+	d, ok := retryAfter(resp)
+	if !ok || d != 2*time.Second {
+		t.Errorf("retryAfter = %v, %v, expected %v, true", d, ok, 2*time.Second)
+	}
+}
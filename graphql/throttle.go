@@ -0,0 +1,122 @@
+package graphqlclient
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	defaultEstimatedQueryCost = 50
+)
+
+// ThrottleStatus is the state of the shop's calculated query cost bucket.
+type ThrottleStatus struct {
+	MaximumAvailable   float64 `json:"maximumAvailable"`
+	CurrentlyAvailable float64 `json:"currentlyAvailable"`
+	RestoreRate        float64 `json:"restoreRate"`
+}
+
+// QueryCost is the cost of a query as reported in the `extensions.cost` field of a response.
+type QueryCost struct {
+	RequestedQueryCost float64        `json:"requestedQueryCost"`
+	ActualQueryCost    *float64       `json:"actualQueryCost"`
+	ThrottleStatus     ThrottleStatus `json:"throttleStatus"`
+}
+
+// RateLimiter paces requests so that the shop's query cost bucket doesn't get drained.
+// Implementations must be safe for concurrent use.
+type RateLimiter interface {
+	// Wait blocks until a request can be sent or the context is done.
+	Wait(ctx context.Context) error
+	// Update reconciles the limiter with the cost reported by Shopify.
+	Update(cost QueryCost)
+}
+
+// LeakyBucket is a RateLimiter modelling Shopify's leaky bucket algorithm.
+// It doesn't limit anything until the first throttle status is received.
+// A single LeakyBucket can be shared by several clients of the same shop.
+type LeakyBucket struct {
+	mu sync.Mutex
+
+	maximum     float64
+	available   float64
+	restoreRate float64
+	updatedAt   time.Time
+
+	// estimate is the cost reserved for every request while its actual cost is unknown.
+	estimate float64
+}
+
+var _ RateLimiter = &LeakyBucket{}
+
+// NewLeakyBucket creates an empty leaky bucket limiter.
+func NewLeakyBucket() *LeakyBucket {
+	return &LeakyBucket{
+		estimate: defaultEstimatedQueryCost,
+	}
+}
+
+// Wait blocks until the bucket has enough capacity for the estimated query cost.
+func (b *LeakyBucket) Wait(ctx context.Context) error {
+	for {
+		delay := b.reserve(time.Now())
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Update sets the bucket state to the one reported by Shopify.
+func (b *LeakyBucket) Update(cost QueryCost) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	status := cost.ThrottleStatus
+	if status.MaximumAvailable <= 0 {
+		return
+	}
+
+	b.maximum = status.MaximumAvailable
+	b.available = status.CurrentlyAvailable
+	b.restoreRate = status.RestoreRate
+	b.updatedAt = time.Now()
+
+	if cost.RequestedQueryCost > 0 {
+		b.estimate = math.Min(cost.RequestedQueryCost, b.maximum)
+	}
+}
+
+// reserve takes the estimated cost out of the bucket and returns zero,
+// or returns how long to wait until the bucket is refilled enough.
+func (b *LeakyBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.maximum == 0 {
+		return 0
+	}
+
+	b.available = math.Min(b.maximum, b.available+now.Sub(b.updatedAt).Seconds()*b.restoreRate)
+	b.updatedAt = now
+
+	if b.available >= b.estimate {
+		b.available -= b.estimate
+		return 0
+	}
+
+	if b.restoreRate <= 0 {
+		return time.Second
+	}
+
+	return time.Duration((b.estimate - b.available) / b.restoreRate * float64(time.Second))
+}
@@ -0,0 +1,88 @@
+package graphqlclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLeakyBucketUnlimitedUntilUpdated(t *testing.T) {
+	b := NewLeakyBucket()
+
+	for i := 0; i < 100; i++ {
+		if delay := b.reserve(time.Now()); delay != 0 {
+			t.Fatalf("reserve delay = %v, expected 0", delay)
+		}
+	}
+}
+
+func TestLeakyBucketReserve(t *testing.T) {
+	b := NewLeakyBucket()
+	b.Update(QueryCost{
+		RequestedQueryCost: 100,
+		ThrottleStatus: ThrottleStatus{
+			MaximumAvailable:   1000,
+			CurrentlyAvailable: 250,
+			RestoreRate:        50,
+		},
+	})
+
+	now := b.updatedAt
+	for i := 0; i < 2; i++ {
+		if delay := b.reserve(now); delay != 0 {
+			t.Fatalf("reserve #%d delay = %v, expected 0", i, delay)
+		}
+	}
+
+	expected := 1 * time.Second
+	if delay := b.reserve(now); delay != expected {
+		t.Errorf("reserve delay = %v, expected %v", delay, expected)
+	}
+
+	if delay := b.reserve(now.Add(expected)); delay != 0 {
+		t.Errorf("reserve after refill delay = %v, expected 0", delay)
+	}
+}
+
+func TestLeakyBucketWaitCancelled(t *testing.T) {
+	b := NewLeakyBucket()
+	b.Update(QueryCost{
+		RequestedQueryCost: 100,
+		ThrottleStatus: ThrottleStatus{
+			MaximumAvailable:   1000,
+			CurrentlyAvailable: 0,
+			RestoreRate:        1,
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := b.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Wait error = %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestTransportUpdatesRateLimiter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{},"extensions":{"cost":{"requestedQueryCost":12,"actualQueryCost":10,"throttleStatus":{"maximumAvailable":2000,"currentlyAvailable":1990,"restoreRate":100}}}}`))
+	}))
+	defer srv.Close()
+
+	b := NewLeakyBucket()
+	tr := &transport{rateLimiter: b}
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, nil)
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if b.maximum != 2000 || b.restoreRate != 100 || b.estimate != 12 {
+		t.Errorf("LeakyBucket = %+v, expected maximum 2000, restore rate 100 and estimate 12", b)
+	}
+}
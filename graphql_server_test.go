@@ -0,0 +1,90 @@
+package shopify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLRequest is a request received by the graphQLServer.
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// graphQLServer is a GraphQL endpoint serving the canned data in order, repeating the last one, and recording
+// the requests, so that the tests can check the documents and variables actually sent. It serves bulkResult
+// to the GET requests, like the downloads of the bulk operation results.
+type graphQLServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	responses  []string
+	bulkResult string
+	requests   []graphQLRequest
+}
+
+func newGraphQLServer(t *testing.T, responses ...string) *graphQLServer {
+	s := &graphQLServer{responses: responses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(s.bulkResult))
+			return
+		}
+
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.requests = append(s.requests, req)
+
+		res := s.responses[0]
+		if len(s.responses) > 1 {
+			s.responses = s.responses[1:]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":` + res + `}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// client returns a client of the server, with an API version running the bulk operations concurrently,
+// so that a bulk operation is only posted and polled.
+func (s *graphQLServer) client(t *testing.T, opts ...Option) *Client {
+	c, err := NewClientFromConfig(Config{StoreName: "store", AccessToken: "token", APIVersion: "2026-01"},
+		append([]Option{WithBaseURL(s.URL), WithLogger(nil), WithBulkPollPolicy(PollPolicy{InitialInterval: time.Millisecond})}, opts...)...)
+	require.NoError(t, err)
+	return c
+}
+
+// request returns the i-th recorded request.
+func (s *graphQLServer) request(t *testing.T, i int) graphQLRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	require.Greater(t, len(s.requests), i, "requests")
+	return s.requests[i]
+}
+
+// last returns the last recorded request.
+func (s *graphQLServer) last(t *testing.T) graphQLRequest {
+	s.mu.Lock()
+	n := len(s.requests)
+	s.mu.Unlock()
+
+	return s.request(t, n-1)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
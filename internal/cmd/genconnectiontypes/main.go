@@ -0,0 +1,153 @@
+// Command genconnectiontypes generates the registrations of the connection types of the go-shopify-graphql-model
+// package used to parse the nested connections of the bulk query results.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const modelModule = "github.com/r0busta/go-shopify-graphql-model/v4"
+
+type edge struct {
+	name string
+	node string
+}
+
+func main() {
+	out := flag.String("o", "bulk_types_gen.go", "output file")
+	flag.Parse()
+
+	dir, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", modelModule).Output()
+	if err != nil {
+		log.Fatalf("locate %s: %s", modelModule, err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filepath.Join(strings.TrimSpace(string(dir)), "graph", "model", "models_gen.go"), nil, 0)
+	if err != nil {
+		log.Fatalf("parse models: %s", err)
+	}
+
+	withID := map[string]bool{}
+	concreteEdges := map[string]edge{}
+	interfaceEdges := map[string]edge{}
+	implementations := map[string][]string{}
+
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				for _, field := range st.Fields.List {
+					for _, name := range field.Names {
+						switch {
+						case name.Name == "ID" && isIdent(field.Type, "string"):
+							withID[ts.Name.Name] = true
+						case name.Name == "Node" && strings.HasSuffix(ts.Name.Name, "Edge"):
+							switch t := field.Type.(type) {
+							case *ast.StarExpr:
+								if ident, ok := t.X.(*ast.Ident); ok {
+									e := edge{name: ts.Name.Name, node: ident.Name}
+									if prev, ok := concreteEdges[ident.Name]; !ok || e.name < prev.name {
+										concreteEdges[ident.Name] = e
+									}
+								}
+							case *ast.Ident:
+								interfaceEdges[t.Name] = edge{name: ts.Name.Name, node: t.Name}
+							}
+						}
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil || len(d.Recv.List) != 1 || !strings.HasPrefix(d.Name.Name, "Is") {
+				continue
+			}
+			recv, ok := d.Recv.List[0].Type.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			iface := strings.TrimPrefix(d.Name.Name, "Is")
+			implementations[iface] = append(implementations[iface], recv.Name)
+		}
+	}
+
+	// The types only reachable through an interface edge, e.g. MediaImage through MediaEdge.
+	nodeOnly := map[string]bool{}
+	for iface := range interfaceEdges {
+		for _, impl := range implementations[iface] {
+			if _, ok := concreteEdges[impl]; !ok && withID[impl] {
+				nodeOnly[impl] = true
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genconnectiontypes. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package shopify\n\n")
+	fmt.Fprintf(&buf, "import \"%s/graph/model\"\n\n", modelModule)
+	fmt.Fprintf(&buf, "func registerModelConnectionTypes() {\n")
+	for _, node := range sortedKeys(concreteEdges) {
+		if !withID[node] {
+			continue
+		}
+		e := concreteEdges[node]
+		fmt.Fprintf(&buf, "\tRegisterConnectionType(%q, model.%s{}, &model.%s{}, %q)\n", node, e.name, node, plural(strings.TrimSuffix(e.name, "Edge")))
+	}
+	for _, node := range sortedKeys(nodeOnly) {
+		fmt.Fprintf(&buf, "\tRegisterConnectionType(%q, nil, &model.%s{}, \"\")\n", node, node)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format: %s", err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatalf("write: %s", err)
+	}
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func plural(s string) string {
+	switch {
+	case strings.HasSuffix(s, "y") && !strings.HasSuffix(s, "ay") && !strings.HasSuffix(s, "ey"):
+		return strings.TrimSuffix(s, "y") + "ies"
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "ch"):
+		return s + "es"
+	default:
+		return s + "s"
+	}
+}
@@ -42,13 +42,13 @@ type mutationInventoryActivate struct {
 
 type mutationInventoryAdjustQuantities struct {
 	InventoryAdjustQuantitiesResult struct {
-		UserErrors []model.UserError `json:"userErrors,omitempty"`
+		UserErrors []model.InventoryAdjustQuantitiesUserError `json:"userErrors,omitempty"`
 	} `graphql:"inventoryAdjustQuantities(input: $input)" json:"inventoryAdjustQuantities"`
 }
 
 type mutationInventorySetOnHandQuantities struct {
 	InventorySetOnHandQuantitiesResult struct {
-		UserErrors []model.UserError `json:"userErrors,omitempty"`
+		UserErrors []model.InventorySetOnHandQuantitiesUserError `json:"userErrors,omitempty"`
 	} `graphql:"inventorySetOnHandQuantities(input: $input)" json:"inventorySetOnHandQuantities"`
 }
 
@@ -63,8 +63,8 @@ func (s *InventoryServiceOp) Update(ctx context.Context, id string, input model.
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.InventoryItemUpdateResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.InventoryItemUpdateResult.UserErrors)
+	if err := newUserErrorsError(m.InventoryItemUpdateResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -81,8 +81,8 @@ func (s *InventoryServiceOp) Adjust(ctx context.Context, locationID string, inpu
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.InventoryBulkAdjustQuantityAtLocationResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.InventoryBulkAdjustQuantityAtLocationResult.UserErrors)
+	if err := newUserErrorsError(m.InventoryBulkAdjustQuantityAtLocationResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -103,8 +103,8 @@ func (s *InventoryServiceOp) AdjustQuantities(ctx context.Context, reason, name
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.InventoryAdjustQuantitiesResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.InventoryAdjustQuantitiesResult.UserErrors)
+	if err := newUserErrorsError(m.InventoryAdjustQuantitiesResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -124,8 +124,8 @@ func (s *InventoryServiceOp) SetOnHandQuantities(ctx context.Context, reason str
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.InventorySetOnHandQuantitiesResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.InventorySetOnHandQuantitiesResult.UserErrors)
+	if err := newUserErrorsError(m.InventorySetOnHandQuantitiesResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -142,8 +142,8 @@ func (s *InventoryServiceOp) ActivateInventory(ctx context.Context, locationID s
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.InventoryActivateResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.InventoryActivateResult.UserErrors)
+	if err := newUserErrorsError(m.InventoryActivateResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -38,5 +38,9 @@ func (s *LocationServiceOp) Get(ctx context.Context, id string) (*model.Location
 		return nil, fmt.Errorf("query: %w", err)
 	}
 
+	if out.Location == nil {
+		return nil, fmt.Errorf("location %s: %w", id, ErrNotFound)
+	}
+
 	return out.Location, nil
 }
@@ -0,0 +1,39 @@
+package shopify
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Logger is the logging interface used by the client and its services. *slog.Logger implements it.
+type Logger interface {
+	Log(ctx context.Context, level slog.Level, msg string, args ...any)
+}
+
+// NewSlogLogger creates a Logger writing to the slog handler.
+func NewSlogLogger(h slog.Handler) Logger {
+	return slog.New(h)
+}
+
+// WithLogger sets the client logger. Pass nil to silence the client.
+// By default, the client logs via slog.Default().
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		if logger == nil {
+			logger = nopLogger{}
+		}
+		c.logger = logger
+	}
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Log(ctx context.Context, level slog.Level, msg string, args ...any) {}
+
+// log logs the message with the shop name attached.
+func (c *Client) log(ctx context.Context, level slog.Level, msg string, args ...any) {
+	if c.shopName != "" {
+		args = append([]any{"shop", c.shopName}, args...)
+	}
+	c.logger.Log(ctx, level, msg, args...)
+}
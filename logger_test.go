@@ -0,0 +1,84 @@
+package shopify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLocationServer serves the location query along with its cost, like Shopify does.
+func newLocationServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data":{"location":{"id":"gid://shopify/Location/1","name":"Warehouse"}},
+			"extensions":{"cost":{"requestedQueryCost":2,"actualQueryCost":1,"throttleStatus":{"maximumAvailable":2000,"currentlyAvailable":1999,"restoreRate":100}}}
+		}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestSlogLogger(t *testing.T) {
+	srv := newLocationServer(t)
+
+	var buf bytes.Buffer
+	logger := NewSlogLogger(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c, err := NewClientWithTokenE("token", "store", WithBaseURL(srv.URL), WithLogger(logger))
+	require.NoError(t, err)
+
+	_, err = c.Location.Get(context.Background(), "gid://shopify/Location/1")
+	require.NoError(t, err)
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record), buf.String())
+	assert.Equal(t, "DEBUG", record["level"])
+	assert.Equal(t, "GraphQL operation done", record["msg"])
+	assert.Equal(t, "store", record["shop"])
+	assert.Equal(t, "location", record["operation"])
+	assert.Equal(t, float64(2), record["requested_cost"])
+	assert.Equal(t, float64(1), record["actual_cost"])
+	assert.Equal(t, float64(1999), record["currently_available"])
+}
+
+func TestSlogLoggerLevel(t *testing.T) {
+	srv := newLocationServer(t)
+
+	var buf bytes.Buffer
+	logger := NewSlogLogger(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	c, err := NewClientWithTokenE("token", "store", WithBaseURL(srv.URL), WithLogger(logger))
+	require.NoError(t, err)
+
+	_, err = c.Location.Get(context.Background(), "gid://shopify/Location/1")
+	require.NoError(t, err)
+	assert.Empty(t, buf.String(), "the operations are logged at the debug level")
+}
+
+func TestWithNilLogger(t *testing.T) {
+	srv := newLocationServer(t)
+
+	var buf bytes.Buffer
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+	c, err := NewClientWithTokenE("token", "store", WithBaseURL(srv.URL))
+	require.NoError(t, err)
+	_, err = c.Location.Get(context.Background(), "gid://shopify/Location/1")
+	require.NoError(t, err)
+	assert.NotEmpty(t, buf.String(), "the client logs via slog.Default() by default")
+
+	buf.Reset()
+	c, err = NewClientWithTokenE("token", "store", WithBaseURL(srv.URL), WithLogger(nil))
+	require.NoError(t, err)
+	_, err = c.Location.Get(context.Background(), "gid://shopify/Location/1")
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
@@ -3,16 +3,29 @@ package shopify
 import (
 	"context"
 	"fmt"
-	"strings"
+	"log/slog"
 
 	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
-	log "github.com/sirupsen/logrus"
 )
 
+const shopMetafieldQuery = `
+	createdAt
+	description
+	id
+	key
+	legacyResourceId
+	namespace
+	ownerType
+	updatedAt
+	value
+	type
+`
+
 //go:generate mockgen -destination=./mock/metafield_service.go -package=mock . MetafieldService
 type MetafieldService interface {
 	ListAllShopMetafields(ctx context.Context) ([]model.Metafield, error)
 	ListShopMetafieldsByNamespace(ctx context.Context, namespace string) ([]model.Metafield, error)
+	PaginateShopMetafields(namespace string, opts ListOptions) *MetafieldPaginator
 
 	GetShopMetafieldByKey(ctx context.Context, namespace, key string) (*model.Metafield, error)
 
@@ -26,6 +39,11 @@ type MetafieldServiceOp struct {
 
 var _ MetafieldService = &MetafieldServiceOp{}
 
+// MetafieldPaginator pages through the metafields.
+type MetafieldPaginator struct {
+	*Paginator[model.Metafield]
+}
+
 type mutationMetafieldDelete struct {
 	MetafieldDeleteResult struct {
 		UserErrors []model.UserError `json:"userErrors,omitempty"`
@@ -66,10 +84,10 @@ func (s *MetafieldServiceOp) ListAllShopMetafields(ctx context.Context) ([]model
 }
 
 func (s *MetafieldServiceOp) ListShopMetafieldsByNamespace(ctx context.Context, namespace string) ([]model.Metafield, error) {
-	q := `
+	q := fmt.Sprintf(`
 		{
 			shop{
-				metafields(namespace: "$namespace"){
+				metafields(namespace: %s){
 					edges{
 						node{
 							createdAt
@@ -87,8 +105,7 @@ func (s *MetafieldServiceOp) ListShopMetafieldsByNamespace(ctx context.Context,
 				}	  
 			}
 		}
-`
-	q = strings.ReplaceAll(q, "$namespace", namespace)
+`, graphQLString(namespace))
 
 	res := []model.Metafield{}
 	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
@@ -99,6 +116,21 @@ func (s *MetafieldServiceOp) ListShopMetafieldsByNamespace(ctx context.Context,
 	return res, nil
 }
 
+// PaginateShopMetafields pages through the shop metafields, of all namespaces if the namespace is empty.
+func (s *MetafieldServiceOp) PaginateShopMetafields(namespace string, opts ListOptions) *MetafieldPaginator {
+	vars := map[string]interface{}{}
+	if namespace != "" {
+		vars["namespace"] = namespace
+	}
+
+	return &MetafieldPaginator{NewPaginator(opts, fetchConnection[model.Metafield](s.client, opts, connectionQuery{
+		field:  "shop.metafields",
+		params: []string{"$namespace: String"},
+		vars:   vars,
+		def:    selectionSet{fields: shopMetafieldQuery},
+	}))}
+}
+
 func (s *MetafieldServiceOp) GetShopMetafieldByKey(ctx context.Context, namespace, key string) (*model.Metafield, error) {
 	var q struct {
 		Shop struct {
@@ -122,7 +154,7 @@ func (s *MetafieldServiceOp) DeleteBulk(ctx context.Context, metafields []model.
 	for _, m := range metafields {
 		err := s.Delete(ctx, m)
 		if err != nil {
-			log.Warnf("Couldn't delete metafield (%v): %s", m, err)
+			s.client.log(ctx, slog.LevelWarn, "Couldn't delete metafield", "input", m, "error", err)
 		}
 	}
 
@@ -140,8 +172,8 @@ func (s *MetafieldServiceOp) Delete(ctx context.Context, metafield model.Metafie
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.MetafieldDeleteResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.MetafieldDeleteResult.UserErrors)
+	if err := newUserErrorsError(m.MetafieldDeleteResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
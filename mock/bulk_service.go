@@ -6,11 +6,13 @@ package mock
 
 import (
 	context "context"
+	iter "iter"
 	reflect "reflect"
 	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	shopify "github.com/r0busta/go-shopify-graphql/v9"
 )
 
 // MockBulkOperationService is a mock of BulkOperationService interface.
@@ -36,6 +38,21 @@ func (m *MockBulkOperationService) EXPECT() *MockBulkOperationServiceMockRecorde
 	return m.recorder
 }
 
+// BulkMutation mocks base method.
+func (m *MockBulkOperationService) BulkMutation(arg0 context.Context, arg1 string, arg2 iter.Seq[map[string]interface{}]) ([]shopify.BulkMutationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkMutation", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]shopify.BulkMutationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkMutation indicates an expected call of BulkMutation.
+func (mr *MockBulkOperationServiceMockRecorder) BulkMutation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkMutation", reflect.TypeOf((*MockBulkOperationService)(nil).BulkMutation), arg0, arg1, arg2)
+}
+
 // BulkQuery mocks base method.
 func (m *MockBulkOperationService) BulkQuery(arg0 context.Context, arg1 string, arg2 interface{}) error {
 	m.ctrl.T.Helper()
@@ -50,6 +67,20 @@ func (mr *MockBulkOperationServiceMockRecorder) BulkQuery(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkQuery", reflect.TypeOf((*MockBulkOperationService)(nil).BulkQuery), arg0, arg1, arg2)
 }
 
+// BulkQueryEach mocks base method.
+func (m *MockBulkOperationService) BulkQueryEach(arg0 context.Context, arg1 string, arg2 interface{}, arg3 func() error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkQueryEach", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkQueryEach indicates an expected call of BulkQueryEach.
+func (mr *MockBulkOperationServiceMockRecorder) BulkQueryEach(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkQueryEach", reflect.TypeOf((*MockBulkOperationService)(nil).BulkQueryEach), arg0, arg1, arg2, arg3)
+}
+
 // CancelRunningBulkQuery mocks base method.
 func (m *MockBulkOperationService) CancelRunningBulkQuery(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -64,6 +95,21 @@ func (mr *MockBulkOperationServiceMockRecorder) CancelRunningBulkQuery(arg0 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRunningBulkQuery", reflect.TypeOf((*MockBulkOperationService)(nil).CancelRunningBulkQuery), arg0)
 }
 
+// GetBulkOperation mocks base method.
+func (m *MockBulkOperationService) GetBulkOperation(arg0 context.Context, arg1 string) (*model.BulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBulkOperation", arg0, arg1)
+	ret0, _ := ret[0].(*model.BulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBulkOperation indicates an expected call of GetBulkOperation.
+func (mr *MockBulkOperationServiceMockRecorder) GetBulkOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkOperation", reflect.TypeOf((*MockBulkOperationService)(nil).GetBulkOperation), arg0, arg1)
+}
+
 // GetCurrentBulkQuery mocks base method.
 func (m *MockBulkOperationService) GetCurrentBulkQuery(arg0 context.Context) (*model.BulkOperation, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +155,20 @@ func (mr *MockBulkOperationServiceMockRecorder) PostBulkQuery(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostBulkQuery", reflect.TypeOf((*MockBulkOperationService)(nil).PostBulkQuery), arg0, arg1)
 }
 
+// ResumeBulkQuery mocks base method.
+func (m *MockBulkOperationService) ResumeBulkQuery(arg0 context.Context, arg1 shopify.BulkOperationHandle, arg2 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeBulkQuery", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeBulkQuery indicates an expected call of ResumeBulkQuery.
+func (mr *MockBulkOperationServiceMockRecorder) ResumeBulkQuery(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeBulkQuery", reflect.TypeOf((*MockBulkOperationService)(nil).ResumeBulkQuery), arg0, arg1, arg2)
+}
+
 // ShouldGetBulkQueryResultURL mocks base method.
 func (m *MockBulkOperationService) ShouldGetBulkQueryResultURL(arg0 context.Context, arg1 *string) (*string, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +184,36 @@ func (mr *MockBulkOperationServiceMockRecorder) ShouldGetBulkQueryResultURL(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShouldGetBulkQueryResultURL", reflect.TypeOf((*MockBulkOperationService)(nil).ShouldGetBulkQueryResultURL), arg0, arg1)
 }
 
+// StartBulkQuery mocks base method.
+func (m *MockBulkOperationService) StartBulkQuery(arg0 context.Context, arg1 string) (*shopify.BulkOperationHandle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBulkQuery", arg0, arg1)
+	ret0, _ := ret[0].(*shopify.BulkOperationHandle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBulkQuery indicates an expected call of StartBulkQuery.
+func (mr *MockBulkOperationServiceMockRecorder) StartBulkQuery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBulkQuery", reflect.TypeOf((*MockBulkOperationService)(nil).StartBulkQuery), arg0, arg1)
+}
+
+// WaitForBulkOperation mocks base method.
+func (m *MockBulkOperationService) WaitForBulkOperation(arg0 context.Context, arg1 string, arg2 time.Duration) (*model.BulkOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForBulkOperation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.BulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForBulkOperation indicates an expected call of WaitForBulkOperation.
+func (mr *MockBulkOperationServiceMockRecorder) WaitForBulkOperation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForBulkOperation", reflect.TypeOf((*MockBulkOperationService)(nil).WaitForBulkOperation), arg0, arg1, arg2)
+}
+
 // WaitForCurrentBulkQuery mocks base method.
 func (m *MockBulkOperationService) WaitForCurrentBulkQuery(arg0 context.Context, arg1 time.Duration) (*model.BulkOperation, error) {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	shopify "github.com/r0busta/go-shopify-graphql/v9"
 )
 
 // MockCollectionService is a mock of CollectionService interface.
@@ -65,33 +66,62 @@ func (mr *MockCollectionServiceMockRecorder) CreateBulk(arg0, arg1 interface{})
 }
 
 // Get mocks base method.
-func (m *MockCollectionService) Get(arg0 context.Context, arg1 string) (*model.Collection, error) {
+func (m *MockCollectionService) Get(arg0 context.Context, arg1 string, arg2 ...shopify.FieldSelector) (*model.Collection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
 	ret0, _ := ret[0].(*model.Collection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockCollectionServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockCollectionServiceMockRecorder) Get(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCollectionService)(nil).Get), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCollectionService)(nil).Get), varargs...)
 }
 
 // ListAll mocks base method.
-func (m *MockCollectionService) ListAll(arg0 context.Context) ([]model.Collection, error) {
+func (m *MockCollectionService) ListAll(arg0 context.Context, arg1 ...shopify.FieldSelector) ([]model.Collection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAll", arg0)
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAll", varargs...)
 	ret0, _ := ret[0].([]model.Collection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAll indicates an expected call of ListAll.
-func (mr *MockCollectionServiceMockRecorder) ListAll(arg0 interface{}) *gomock.Call {
+func (mr *MockCollectionServiceMockRecorder) ListAll(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockCollectionService)(nil).ListAll), arg0)
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockCollectionService)(nil).ListAll), varargs...)
+}
+
+// Paginate mocks base method.
+func (m *MockCollectionService) Paginate(arg0 shopify.ListOptions, arg1 ...shopify.FieldSelector) *shopify.CollectionPaginator {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Paginate", varargs...)
+	ret0, _ := ret[0].(*shopify.CollectionPaginator)
+	return ret0
+}
+
+// Paginate indicates an expected call of Paginate.
+func (mr *MockCollectionServiceMockRecorder) Paginate(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Paginate", reflect.TypeOf((*MockCollectionService)(nil).Paginate), varargs...)
 }
 
 // Update mocks base method.
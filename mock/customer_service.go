@@ -0,0 +1,264 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/r0busta/go-shopify-graphql/v9 (interfaces: CustomerService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	shopify "github.com/r0busta/go-shopify-graphql/v9"
+)
+
+// MockCustomerService is a mock of CustomerService interface.
+type MockCustomerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerServiceMockRecorder
+}
+
+// MockCustomerServiceMockRecorder is the mock recorder for MockCustomerService.
+type MockCustomerServiceMockRecorder struct {
+	mock *MockCustomerService
+}
+
+// NewMockCustomerService creates a new mock instance.
+func NewMockCustomerService(ctrl *gomock.Controller) *MockCustomerService {
+	mock := &MockCustomerService{ctrl: ctrl}
+	mock.recorder = &MockCustomerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerService) EXPECT() *MockCustomerServiceMockRecorder {
+	return m.recorder
+}
+
+// AddTags mocks base method.
+func (m *MockCustomerService) AddTags(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockCustomerServiceMockRecorder) AddTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockCustomerService)(nil).AddTags), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockCustomerService) Create(arg0 context.Context, arg1 model.CustomerInput) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCustomerServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCustomerService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockCustomerService) Delete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCustomerServiceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCustomerService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockCustomerService) Get(arg0 context.Context, arg1 string, arg2 ...shopify.FieldSelector) (*model.Customer, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(*model.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCustomerServiceMockRecorder) Get(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCustomerService)(nil).Get), varargs...)
+}
+
+// GetByEmail mocks base method.
+func (m *MockCustomerService) GetByEmail(arg0 context.Context, arg1 string, arg2 ...shopify.FieldSelector) (*model.Customer, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetByEmail", varargs...)
+	ret0, _ := ret[0].(*model.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmail indicates an expected call of GetByEmail.
+func (mr *MockCustomerServiceMockRecorder) GetByEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockCustomerService)(nil).GetByEmail), varargs...)
+}
+
+// List mocks base method.
+func (m *MockCustomerService) List(arg0 context.Context, arg1 string, arg2 ...shopify.FieldSelector) ([]model.Customer, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]model.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCustomerServiceMockRecorder) List(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCustomerService)(nil).List), varargs...)
+}
+
+// ListAll mocks base method.
+func (m *MockCustomerService) ListAll(arg0 context.Context, arg1 ...shopify.FieldSelector) ([]model.Customer, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAll", varargs...)
+	ret0, _ := ret[0].([]model.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockCustomerServiceMockRecorder) ListAll(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockCustomerService)(nil).ListAll), varargs...)
+}
+
+// Merge mocks base method.
+func (m *MockCustomerService) Merge(arg0 context.Context, arg1, arg2 string, arg3 *model.CustomerMergeOverrideFields) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockCustomerServiceMockRecorder) Merge(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockCustomerService)(nil).Merge), arg0, arg1, arg2, arg3)
+}
+
+// Paginate mocks base method.
+func (m *MockCustomerService) Paginate(arg0 shopify.ListOptions, arg1 ...shopify.FieldSelector) *shopify.CustomerPaginator {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Paginate", varargs...)
+	ret0, _ := ret[0].(*shopify.CustomerPaginator)
+	return ret0
+}
+
+// Paginate indicates an expected call of Paginate.
+func (mr *MockCustomerServiceMockRecorder) Paginate(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Paginate", reflect.TypeOf((*MockCustomerService)(nil).Paginate), varargs...)
+}
+
+// RemoveTags mocks base method.
+func (m *MockCustomerService) RemoveTags(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTags indicates an expected call of RemoveTags.
+func (mr *MockCustomerServiceMockRecorder) RemoveTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockCustomerService)(nil).RemoveTags), arg0, arg1, arg2)
+}
+
+// SetDefaultAddress mocks base method.
+func (m *MockCustomerService) SetDefaultAddress(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultAddress", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultAddress indicates an expected call of SetDefaultAddress.
+func (mr *MockCustomerServiceMockRecorder) SetDefaultAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultAddress", reflect.TypeOf((*MockCustomerService)(nil).SetDefaultAddress), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockCustomerService) Update(arg0 context.Context, arg1 model.CustomerInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCustomerServiceMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCustomerService)(nil).Update), arg0, arg1)
+}
+
+// UpdateAddresses mocks base method.
+func (m *MockCustomerService) UpdateAddresses(arg0 context.Context, arg1 string, arg2 []model.MailingAddressInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAddresses", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAddresses indicates an expected call of UpdateAddresses.
+func (mr *MockCustomerServiceMockRecorder) UpdateAddresses(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAddresses", reflect.TypeOf((*MockCustomerService)(nil).UpdateAddresses), arg0, arg1, arg2)
+}
+
+// UpdateEmailMarketingConsent mocks base method.
+func (m *MockCustomerService) UpdateEmailMarketingConsent(arg0 context.Context, arg1 model.CustomerEmailMarketingConsentUpdateInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmailMarketingConsent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEmailMarketingConsent indicates an expected call of UpdateEmailMarketingConsent.
+func (mr *MockCustomerServiceMockRecorder) UpdateEmailMarketingConsent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmailMarketingConsent", reflect.TypeOf((*MockCustomerService)(nil).UpdateEmailMarketingConsent), arg0, arg1)
+}
@@ -0,0 +1,230 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/r0busta/go-shopify-graphql/v9 (interfaces: DraftOrderService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	shopify "github.com/r0busta/go-shopify-graphql/v9"
+)
+
+// MockDraftOrderService is a mock of DraftOrderService interface.
+type MockDraftOrderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftOrderServiceMockRecorder
+}
+
+// MockDraftOrderServiceMockRecorder is the mock recorder for MockDraftOrderService.
+type MockDraftOrderServiceMockRecorder struct {
+	mock *MockDraftOrderService
+}
+
+// NewMockDraftOrderService creates a new mock instance.
+func NewMockDraftOrderService(ctrl *gomock.Controller) *MockDraftOrderService {
+	mock := &MockDraftOrderService{ctrl: ctrl}
+	mock.recorder = &MockDraftOrderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftOrderService) EXPECT() *MockDraftOrderServiceMockRecorder {
+	return m.recorder
+}
+
+// BulkAddTags mocks base method.
+func (m *MockDraftOrderService) BulkAddTags(arg0 context.Context, arg1, arg2 []string) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkAddTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkAddTags indicates an expected call of BulkAddTags.
+func (mr *MockDraftOrderServiceMockRecorder) BulkAddTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkAddTags", reflect.TypeOf((*MockDraftOrderService)(nil).BulkAddTags), arg0, arg1, arg2)
+}
+
+// BulkRemoveTags mocks base method.
+func (m *MockDraftOrderService) BulkRemoveTags(arg0 context.Context, arg1, arg2 []string) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkRemoveTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkRemoveTags indicates an expected call of BulkRemoveTags.
+func (mr *MockDraftOrderServiceMockRecorder) BulkRemoveTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkRemoveTags", reflect.TypeOf((*MockDraftOrderService)(nil).BulkRemoveTags), arg0, arg1, arg2)
+}
+
+// Calculate mocks base method.
+func (m *MockDraftOrderService) Calculate(arg0 context.Context, arg1 model.DraftOrderInput) (*model.CalculatedDraftOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", arg0, arg1)
+	ret0, _ := ret[0].(*model.CalculatedDraftOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockDraftOrderServiceMockRecorder) Calculate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockDraftOrderService)(nil).Calculate), arg0, arg1)
+}
+
+// Complete mocks base method.
+func (m *MockDraftOrderService) Complete(arg0 context.Context, arg1 string, arg2 bool) (*model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockDraftOrderServiceMockRecorder) Complete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockDraftOrderService)(nil).Complete), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockDraftOrderService) Create(arg0 context.Context, arg1 model.DraftOrderInput) (*model.DraftOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*model.DraftOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDraftOrderServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDraftOrderService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDraftOrderService) Delete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDraftOrderServiceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDraftOrderService)(nil).Delete), arg0, arg1)
+}
+
+// Duplicate mocks base method.
+func (m *MockDraftOrderService) Duplicate(arg0 context.Context, arg1 string) (*model.DraftOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Duplicate", arg0, arg1)
+	ret0, _ := ret[0].(*model.DraftOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Duplicate indicates an expected call of Duplicate.
+func (mr *MockDraftOrderServiceMockRecorder) Duplicate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Duplicate", reflect.TypeOf((*MockDraftOrderService)(nil).Duplicate), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockDraftOrderService) Get(arg0 context.Context, arg1 string, arg2 ...shopify.FieldSelector) (*model.DraftOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(*model.DraftOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDraftOrderServiceMockRecorder) Get(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDraftOrderService)(nil).Get), varargs...)
+}
+
+// List mocks base method.
+func (m *MockDraftOrderService) List(arg0 context.Context, arg1 shopify.ListOptions, arg2 ...shopify.FieldSelector) ([]model.DraftOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]model.DraftOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockDraftOrderServiceMockRecorder) List(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDraftOrderService)(nil).List), varargs...)
+}
+
+// ListAll mocks base method.
+func (m *MockDraftOrderService) ListAll(arg0 context.Context, arg1 ...shopify.FieldSelector) ([]model.DraftOrder, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAll", varargs...)
+	ret0, _ := ret[0].([]model.DraftOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockDraftOrderServiceMockRecorder) ListAll(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockDraftOrderService)(nil).ListAll), varargs...)
+}
+
+// SendInvoice mocks base method.
+func (m *MockDraftOrderService) SendInvoice(arg0 context.Context, arg1 string, arg2 *model.EmailInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendInvoice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendInvoice indicates an expected call of SendInvoice.
+func (mr *MockDraftOrderServiceMockRecorder) SendInvoice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvoice", reflect.TypeOf((*MockDraftOrderService)(nil).SendInvoice), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockDraftOrderService) Update(arg0 context.Context, arg1 string, arg2 model.DraftOrderInput) (*model.DraftOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.DraftOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDraftOrderServiceMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDraftOrderService)(nil).Update), arg0, arg1, arg2)
+}
@@ -0,0 +1,277 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/r0busta/go-shopify-graphql/v9 (interfaces: FulfillmentOrderService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	shopify "github.com/r0busta/go-shopify-graphql/v9"
+)
+
+// MockFulfillmentOrderService is a mock of FulfillmentOrderService interface.
+type MockFulfillmentOrderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFulfillmentOrderServiceMockRecorder
+}
+
+// MockFulfillmentOrderServiceMockRecorder is the mock recorder for MockFulfillmentOrderService.
+type MockFulfillmentOrderServiceMockRecorder struct {
+	mock *MockFulfillmentOrderService
+}
+
+// NewMockFulfillmentOrderService creates a new mock instance.
+func NewMockFulfillmentOrderService(ctrl *gomock.Controller) *MockFulfillmentOrderService {
+	mock := &MockFulfillmentOrderService{ctrl: ctrl}
+	mock.recorder = &MockFulfillmentOrderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFulfillmentOrderService) EXPECT() *MockFulfillmentOrderServiceMockRecorder {
+	return m.recorder
+}
+
+// AcceptCancellationRequest mocks base method.
+func (m *MockFulfillmentOrderService) AcceptCancellationRequest(arg0 context.Context, arg1, arg2 string) (*model.FulfillmentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptCancellationRequest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.FulfillmentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptCancellationRequest indicates an expected call of AcceptCancellationRequest.
+func (mr *MockFulfillmentOrderServiceMockRecorder) AcceptCancellationRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptCancellationRequest", reflect.TypeOf((*MockFulfillmentOrderService)(nil).AcceptCancellationRequest), arg0, arg1, arg2)
+}
+
+// AcceptFulfillmentRequest mocks base method.
+func (m *MockFulfillmentOrderService) AcceptFulfillmentRequest(arg0 context.Context, arg1, arg2 string) (*model.FulfillmentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptFulfillmentRequest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.FulfillmentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptFulfillmentRequest indicates an expected call of AcceptFulfillmentRequest.
+func (mr *MockFulfillmentOrderServiceMockRecorder) AcceptFulfillmentRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptFulfillmentRequest", reflect.TypeOf((*MockFulfillmentOrderService)(nil).AcceptFulfillmentRequest), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockFulfillmentOrderService) Get(arg0 context.Context, arg1 string) (*model.FulfillmentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*model.FulfillmentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFulfillmentOrderServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFulfillmentOrderService)(nil).Get), arg0, arg1)
+}
+
+// Hold mocks base method.
+func (m *MockFulfillmentOrderService) Hold(arg0 context.Context, arg1 string, arg2 model.FulfillmentOrderHoldInput) (*model.FulfillmentOrderHoldPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hold", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.FulfillmentOrderHoldPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hold indicates an expected call of Hold.
+func (mr *MockFulfillmentOrderServiceMockRecorder) Hold(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hold", reflect.TypeOf((*MockFulfillmentOrderService)(nil).Hold), arg0, arg1, arg2)
+}
+
+// ListAssigned mocks base method.
+func (m *MockFulfillmentOrderService) ListAssigned(arg0 context.Context, arg1 shopify.AssignedFulfillmentOrdersOptions) ([]model.FulfillmentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssigned", arg0, arg1)
+	ret0, _ := ret[0].([]model.FulfillmentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssigned indicates an expected call of ListAssigned.
+func (mr *MockFulfillmentOrderServiceMockRecorder) ListAssigned(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssigned", reflect.TypeOf((*MockFulfillmentOrderService)(nil).ListAssigned), arg0, arg1)
+}
+
+// ListByOrder mocks base method.
+func (m *MockFulfillmentOrderService) ListByOrder(arg0 context.Context, arg1 string) ([]model.FulfillmentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOrder", arg0, arg1)
+	ret0, _ := ret[0].([]model.FulfillmentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOrder indicates an expected call of ListByOrder.
+func (mr *MockFulfillmentOrderServiceMockRecorder) ListByOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOrder", reflect.TypeOf((*MockFulfillmentOrderService)(nil).ListByOrder), arg0, arg1)
+}
+
+// Merge mocks base method.
+func (m *MockFulfillmentOrderService) Merge(arg0 context.Context, arg1 []model.FulfillmentOrderMergeInput) ([]model.FulfillmentOrderMergeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", arg0, arg1)
+	ret0, _ := ret[0].([]model.FulfillmentOrderMergeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockFulfillmentOrderServiceMockRecorder) Merge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockFulfillmentOrderService)(nil).Merge), arg0, arg1)
+}
+
+// Move mocks base method.
+func (m *MockFulfillmentOrderService) Move(arg0 context.Context, arg1, arg2 string, arg3 []model.FulfillmentOrderLineItemInput) (*model.FulfillmentOrderMovePayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.FulfillmentOrderMovePayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockFulfillmentOrderServiceMockRecorder) Move(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockFulfillmentOrderService)(nil).Move), arg0, arg1, arg2, arg3)
+}
+
+// PaginateAssigned mocks base method.
+func (m *MockFulfillmentOrderService) PaginateAssigned(arg0 shopify.AssignedFulfillmentOrdersOptions) *shopify.FulfillmentOrderPaginator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaginateAssigned", arg0)
+	ret0, _ := ret[0].(*shopify.FulfillmentOrderPaginator)
+	return ret0
+}
+
+// PaginateAssigned indicates an expected call of PaginateAssigned.
+func (mr *MockFulfillmentOrderServiceMockRecorder) PaginateAssigned(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaginateAssigned", reflect.TypeOf((*MockFulfillmentOrderService)(nil).PaginateAssigned), arg0)
+}
+
+// RejectCancellationRequest mocks base method.
+func (m *MockFulfillmentOrderService) RejectCancellationRequest(arg0 context.Context, arg1, arg2 string) (*model.FulfillmentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectCancellationRequest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.FulfillmentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectCancellationRequest indicates an expected call of RejectCancellationRequest.
+func (mr *MockFulfillmentOrderServiceMockRecorder) RejectCancellationRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectCancellationRequest", reflect.TypeOf((*MockFulfillmentOrderService)(nil).RejectCancellationRequest), arg0, arg1, arg2)
+}
+
+// RejectFulfillmentRequest mocks base method.
+func (m *MockFulfillmentOrderService) RejectFulfillmentRequest(arg0 context.Context, arg1, arg2 string) (*model.FulfillmentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectFulfillmentRequest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.FulfillmentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectFulfillmentRequest indicates an expected call of RejectFulfillmentRequest.
+func (mr *MockFulfillmentOrderServiceMockRecorder) RejectFulfillmentRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectFulfillmentRequest", reflect.TypeOf((*MockFulfillmentOrderService)(nil).RejectFulfillmentRequest), arg0, arg1, arg2)
+}
+
+// ReleaseHold mocks base method.
+func (m *MockFulfillmentOrderService) ReleaseHold(arg0 context.Context, arg1 string, arg2 []string) (*model.FulfillmentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.FulfillmentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockFulfillmentOrderServiceMockRecorder) ReleaseHold(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockFulfillmentOrderService)(nil).ReleaseHold), arg0, arg1, arg2)
+}
+
+// Reschedule mocks base method.
+func (m *MockFulfillmentOrderService) Reschedule(arg0 context.Context, arg1 string, arg2 time.Time) (*model.FulfillmentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.FulfillmentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockFulfillmentOrderServiceMockRecorder) Reschedule(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockFulfillmentOrderService)(nil).Reschedule), arg0, arg1, arg2)
+}
+
+// Split mocks base method.
+func (m *MockFulfillmentOrderService) Split(arg0 context.Context, arg1 []model.FulfillmentOrderSplitInput) ([]model.FulfillmentOrderSplitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Split", arg0, arg1)
+	ret0, _ := ret[0].([]model.FulfillmentOrderSplitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Split indicates an expected call of Split.
+func (mr *MockFulfillmentOrderServiceMockRecorder) Split(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Split", reflect.TypeOf((*MockFulfillmentOrderService)(nil).Split), arg0, arg1)
+}
+
+// SubmitCancellationRequest mocks base method.
+func (m *MockFulfillmentOrderService) SubmitCancellationRequest(arg0 context.Context, arg1, arg2 string) (*model.FulfillmentOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCancellationRequest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.FulfillmentOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitCancellationRequest indicates an expected call of SubmitCancellationRequest.
+func (mr *MockFulfillmentOrderServiceMockRecorder) SubmitCancellationRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCancellationRequest", reflect.TypeOf((*MockFulfillmentOrderService)(nil).SubmitCancellationRequest), arg0, arg1, arg2)
+}
+
+// SubmitFulfillmentRequest mocks base method.
+func (m *MockFulfillmentOrderService) SubmitFulfillmentRequest(arg0 context.Context, arg1, arg2 string, arg3 bool) (*model.FulfillmentOrderSubmitFulfillmentRequestPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitFulfillmentRequest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.FulfillmentOrderSubmitFulfillmentRequestPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitFulfillmentRequest indicates an expected call of SubmitFulfillmentRequest.
+func (mr *MockFulfillmentOrderServiceMockRecorder) SubmitFulfillmentRequest(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitFulfillmentRequest", reflect.TypeOf((*MockFulfillmentOrderService)(nil).SubmitFulfillmentRequest), arg0, arg1, arg2, arg3)
+}
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	shopify "github.com/r0busta/go-shopify-graphql/v9"
 )
 
 // MockMetafieldService is a mock of MetafieldService interface.
@@ -107,3 +108,17 @@ func (mr *MockMetafieldServiceMockRecorder) ListShopMetafieldsByNamespace(arg0,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShopMetafieldsByNamespace", reflect.TypeOf((*MockMetafieldService)(nil).ListShopMetafieldsByNamespace), arg0, arg1)
 }
+
+// PaginateShopMetafields mocks base method.
+func (m *MockMetafieldService) PaginateShopMetafields(arg0 string, arg1 shopify.ListOptions) *shopify.MetafieldPaginator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaginateShopMetafields", arg0, arg1)
+	ret0, _ := ret[0].(*shopify.MetafieldPaginator)
+	return ret0
+}
+
+// PaginateShopMetafields indicates an expected call of PaginateShopMetafields.
+func (mr *MockMetafieldServiceMockRecorder) PaginateShopMetafields(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaginateShopMetafields", reflect.TypeOf((*MockMetafieldService)(nil).PaginateShopMetafields), arg0, arg1)
+}
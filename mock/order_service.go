@@ -37,40 +37,127 @@ func (m *MockOrderService) EXPECT() *MockOrderServiceMockRecorder {
 	return m.recorder
 }
 
+// AddTags mocks base method.
+func (m *MockOrderService) AddTags(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockOrderServiceMockRecorder) AddTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockOrderService)(nil).AddTags), arg0, arg1, arg2)
+}
+
+// BeginEdit mocks base method.
+func (m *MockOrderService) BeginEdit(arg0 context.Context, arg1 string) (*shopify.OrderEdit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginEdit", arg0, arg1)
+	ret0, _ := ret[0].(*shopify.OrderEdit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginEdit indicates an expected call of BeginEdit.
+func (mr *MockOrderServiceMockRecorder) BeginEdit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginEdit", reflect.TypeOf((*MockOrderService)(nil).BeginEdit), arg0, arg1)
+}
+
+// Cancel mocks base method.
+func (m *MockOrderService) Cancel(arg0 context.Context, arg1 string, arg2 shopify.OrderCancelOptions) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockOrderServiceMockRecorder) Cancel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockOrderService)(nil).Cancel), arg0, arg1, arg2)
+}
+
+// Capture mocks base method.
+func (m *MockOrderService) Capture(arg0 context.Context, arg1 model.OrderCaptureInput) (*model.OrderTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", arg0, arg1)
+	ret0, _ := ret[0].(*model.OrderTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockOrderServiceMockRecorder) Capture(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockOrderService)(nil).Capture), arg0, arg1)
+}
+
+// Close mocks base method.
+func (m *MockOrderService) Close(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockOrderServiceMockRecorder) Close(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockOrderService)(nil).Close), arg0, arg1)
+}
+
 // Get mocks base method.
-func (m *MockOrderService) Get(arg0 context.Context, arg1 graphql.ID) (*model.Order, error) {
+func (m *MockOrderService) Get(arg0 context.Context, arg1 graphql.ID, arg2 ...shopify.FieldSelector) (*model.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
 	ret0, _ := ret[0].(*model.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockOrderServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) Get(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOrderService)(nil).Get), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOrderService)(nil).Get), varargs...)
 }
 
 // List mocks base method.
-func (m *MockOrderService) List(arg0 context.Context, arg1 shopify.ListOptions) ([]model.Order, error) {
+func (m *MockOrderService) List(arg0 context.Context, arg1 shopify.ListOptions, arg2 ...shopify.FieldSelector) ([]model.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
 	ret0, _ := ret[0].([]model.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockOrderServiceMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) List(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrderService)(nil).List), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrderService)(nil).List), varargs...)
 }
 
 // ListAfterCursor mocks base method.
-func (m *MockOrderService) ListAfterCursor(arg0 context.Context, arg1 shopify.ListOptions) ([]model.Order, *string, *string, error) {
+func (m *MockOrderService) ListAfterCursor(arg0 context.Context, arg1 shopify.ListOptions, arg2 ...shopify.FieldSelector) ([]model.Order, *string, *string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAfterCursor", arg0, arg1)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAfterCursor", varargs...)
 	ret0, _ := ret[0].([]model.Order)
 	ret1, _ := ret[1].(*string)
 	ret2, _ := ret[2].(*string)
@@ -79,24 +166,105 @@ func (m *MockOrderService) ListAfterCursor(arg0 context.Context, arg1 shopify.Li
 }
 
 // ListAfterCursor indicates an expected call of ListAfterCursor.
-func (mr *MockOrderServiceMockRecorder) ListAfterCursor(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) ListAfterCursor(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfterCursor", reflect.TypeOf((*MockOrderService)(nil).ListAfterCursor), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfterCursor", reflect.TypeOf((*MockOrderService)(nil).ListAfterCursor), varargs...)
 }
 
 // ListAll mocks base method.
-func (m *MockOrderService) ListAll(arg0 context.Context) ([]model.Order, error) {
+func (m *MockOrderService) ListAll(arg0 context.Context, arg1 ...shopify.FieldSelector) ([]model.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAll", arg0)
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAll", varargs...)
 	ret0, _ := ret[0].([]model.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAll indicates an expected call of ListAll.
-func (mr *MockOrderServiceMockRecorder) ListAll(arg0 interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) ListAll(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockOrderService)(nil).ListAll), arg0)
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockOrderService)(nil).ListAll), varargs...)
+}
+
+// MarkAsPaid mocks base method.
+func (m *MockOrderService) MarkAsPaid(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsPaid", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAsPaid indicates an expected call of MarkAsPaid.
+func (mr *MockOrderServiceMockRecorder) MarkAsPaid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsPaid", reflect.TypeOf((*MockOrderService)(nil).MarkAsPaid), arg0, arg1)
+}
+
+// Open mocks base method.
+func (m *MockOrderService) Open(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockOrderServiceMockRecorder) Open(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockOrderService)(nil).Open), arg0, arg1)
+}
+
+// Paginate mocks base method.
+func (m *MockOrderService) Paginate(arg0 shopify.ListOptions, arg1 ...shopify.FieldSelector) *shopify.OrderPaginator {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Paginate", varargs...)
+	ret0, _ := ret[0].(*shopify.OrderPaginator)
+	return ret0
+}
+
+// Paginate indicates an expected call of Paginate.
+func (mr *MockOrderServiceMockRecorder) Paginate(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Paginate", reflect.TypeOf((*MockOrderService)(nil).Paginate), varargs...)
+}
+
+// RemoveTags mocks base method.
+func (m *MockOrderService) RemoveTags(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTags indicates an expected call of RemoveTags.
+func (mr *MockOrderServiceMockRecorder) RemoveTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockOrderService)(nil).RemoveTags), arg0, arg1, arg2)
+}
+
+// SendInvoice mocks base method.
+func (m *MockOrderService) SendInvoice(arg0 context.Context, arg1 string, arg2 *model.EmailInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendInvoice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendInvoice indicates an expected call of SendInvoice.
+func (mr *MockOrderServiceMockRecorder) SendInvoice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvoice", reflect.TypeOf((*MockOrderService)(nil).SendInvoice), arg0, arg1, arg2)
 }
 
 // Update mocks base method.
@@ -112,3 +280,17 @@ func (mr *MockOrderServiceMockRecorder) Update(arg0, arg1 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrderService)(nil).Update), arg0, arg1)
 }
+
+// UpdateNote mocks base method.
+func (m *MockOrderService) UpdateNote(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNote indicates an expected call of UpdateNote.
+func (mr *MockOrderServiceMockRecorder) UpdateNote(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNote", reflect.TypeOf((*MockOrderService)(nil).UpdateNote), arg0, arg1, arg2)
+}
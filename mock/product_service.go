@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	shopify "github.com/r0busta/go-shopify-graphql/v9"
 )
 
 // MockProductService is a mock of ProductService interface.
@@ -65,48 +66,63 @@ func (mr *MockProductServiceMockRecorder) Delete(arg0, arg1 interface{}) *gomock
 }
 
 // Get mocks base method.
-func (m *MockProductService) Get(arg0 context.Context, arg1 string) (*model.Product, error) {
+func (m *MockProductService) Get(arg0 context.Context, arg1 string, arg2 ...shopify.FieldSelector) (*model.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
 	ret0, _ := ret[0].(*model.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockProductServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockProductServiceMockRecorder) Get(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProductService)(nil).Get), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProductService)(nil).Get), varargs...)
 }
 
 // List mocks base method.
-func (m *MockProductService) List(arg0 context.Context, arg1 string) ([]model.Product, error) {
+func (m *MockProductService) List(arg0 context.Context, arg1 string, arg2 ...shopify.FieldSelector) ([]model.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
 	ret0, _ := ret[0].([]model.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockProductServiceMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockProductServiceMockRecorder) List(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProductService)(nil).List), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProductService)(nil).List), varargs...)
 }
 
 // ListAll mocks base method.
-func (m *MockProductService) ListAll(arg0 context.Context) ([]model.Product, error) {
+func (m *MockProductService) ListAll(arg0 context.Context, arg1 ...shopify.FieldSelector) ([]model.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAll", arg0)
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAll", varargs...)
 	ret0, _ := ret[0].([]model.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAll indicates an expected call of ListAll.
-func (mr *MockProductServiceMockRecorder) ListAll(arg0 interface{}) *gomock.Call {
+func (mr *MockProductServiceMockRecorder) ListAll(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockProductService)(nil).ListAll), arg0)
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockProductService)(nil).ListAll), varargs...)
 }
 
 // MediaCreate mocks base method.
@@ -123,6 +139,25 @@ func (mr *MockProductServiceMockRecorder) MediaCreate(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaCreate", reflect.TypeOf((*MockProductService)(nil).MediaCreate), arg0, arg1, arg2)
 }
 
+// Paginate mocks base method.
+func (m *MockProductService) Paginate(arg0 shopify.ListOptions, arg1 ...shopify.FieldSelector) *shopify.ProductPaginator {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Paginate", varargs...)
+	ret0, _ := ret[0].(*shopify.ProductPaginator)
+	return ret0
+}
+
+// Paginate indicates an expected call of Paginate.
+func (mr *MockProductServiceMockRecorder) Paginate(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Paginate", reflect.TypeOf((*MockProductService)(nil).Paginate), varargs...)
+}
+
 // Update mocks base method.
 func (m *MockProductService) Update(arg0 context.Context, arg1 model.ProductUpdateInput, arg2 []model.CreateMediaInput) error {
 	m.ctrl.T.Helper()
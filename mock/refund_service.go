@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/r0busta/go-shopify-graphql/v9 (interfaces: RefundService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	shopify "github.com/r0busta/go-shopify-graphql/v9"
+)
+
+// MockRefundService is a mock of RefundService interface.
+type MockRefundService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefundServiceMockRecorder
+}
+
+// MockRefundServiceMockRecorder is the mock recorder for MockRefundService.
+type MockRefundServiceMockRecorder struct {
+	mock *MockRefundService
+}
+
+// NewMockRefundService creates a new mock instance.
+func NewMockRefundService(ctrl *gomock.Controller) *MockRefundService {
+	mock := &MockRefundService{ctrl: ctrl}
+	mock.recorder = &MockRefundServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundService) EXPECT() *MockRefundServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRefundService) Create(arg0 context.Context, arg1 model.RefundInput) (*model.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*model.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRefundServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRefundService)(nil).Create), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockRefundService) Get(arg0 context.Context, arg1 string) (*model.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*model.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRefundServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRefundService)(nil).Get), arg0, arg1)
+}
+
+// Suggest mocks base method.
+func (m *MockRefundService) Suggest(arg0 context.Context, arg1 string, arg2 shopify.SuggestedRefundOptions) (*model.SuggestedRefund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.SuggestedRefund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockRefundServiceMockRecorder) Suggest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockRefundService)(nil).Suggest), arg0, arg1, arg2)
+}
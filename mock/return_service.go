@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/r0busta/go-shopify-graphql/v9 (interfaces: ReturnService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	shopify "github.com/r0busta/go-shopify-graphql/v9"
+)
+
+// MockReturnService is a mock of ReturnService interface.
+type MockReturnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReturnServiceMockRecorder
+}
+
+// MockReturnServiceMockRecorder is the mock recorder for MockReturnService.
+type MockReturnServiceMockRecorder struct {
+	mock *MockReturnService
+}
+
+// NewMockReturnService creates a new mock instance.
+func NewMockReturnService(ctrl *gomock.Controller) *MockReturnService {
+	mock := &MockReturnService{ctrl: ctrl}
+	mock.recorder = &MockReturnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReturnService) EXPECT() *MockReturnServiceMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockReturnService) Approve(arg0 context.Context, arg1 model.ReturnApproveRequestInput) (*shopify.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", arg0, arg1)
+	ret0, _ := ret[0].(*shopify.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockReturnServiceMockRecorder) Approve(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockReturnService)(nil).Approve), arg0, arg1)
+}
+
+// Cancel mocks base method.
+func (m *MockReturnService) Cancel(arg0 context.Context, arg1 string, arg2 bool) (*shopify.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*shopify.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockReturnServiceMockRecorder) Cancel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockReturnService)(nil).Cancel), arg0, arg1, arg2)
+}
+
+// Close mocks base method.
+func (m *MockReturnService) Close(arg0 context.Context, arg1 string) (*shopify.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", arg0, arg1)
+	ret0, _ := ret[0].(*shopify.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockReturnServiceMockRecorder) Close(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReturnService)(nil).Close), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockReturnService) Create(arg0 context.Context, arg1 model.ReturnInput) (*shopify.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*shopify.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReturnServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReturnService)(nil).Create), arg0, arg1)
+}
+
+// Decline mocks base method.
+func (m *MockReturnService) Decline(arg0 context.Context, arg1 model.ReturnDeclineRequestInput) (*shopify.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", arg0, arg1)
+	ret0, _ := ret[0].(*shopify.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MockReturnServiceMockRecorder) Decline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MockReturnService)(nil).Decline), arg0, arg1)
+}
+
+// DisposeReverseFulfillmentOrder mocks base method.
+func (m *MockReturnService) DisposeReverseFulfillmentOrder(arg0 context.Context, arg1 []model.ReverseFulfillmentOrderDisposeInput) ([]model.ReverseFulfillmentOrderLineItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisposeReverseFulfillmentOrder", arg0, arg1)
+	ret0, _ := ret[0].([]model.ReverseFulfillmentOrderLineItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisposeReverseFulfillmentOrder indicates an expected call of DisposeReverseFulfillmentOrder.
+func (mr *MockReturnServiceMockRecorder) DisposeReverseFulfillmentOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisposeReverseFulfillmentOrder", reflect.TypeOf((*MockReturnService)(nil).DisposeReverseFulfillmentOrder), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockReturnService) Get(arg0 context.Context, arg1 string) (*shopify.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*shopify.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReturnServiceMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReturnService)(nil).Get), arg0, arg1)
+}
+
+// Refund mocks base method.
+func (m *MockReturnService) Refund(arg0 context.Context, arg1 model.ReturnRefundInput) (*model.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", arg0, arg1)
+	ret0, _ := ret[0].(*model.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockReturnServiceMockRecorder) Refund(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockReturnService)(nil).Refund), arg0, arg1)
+}
+
+// Reopen mocks base method.
+func (m *MockReturnService) Reopen(arg0 context.Context, arg1 string) (*shopify.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", arg0, arg1)
+	ret0, _ := ret[0].(*shopify.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockReturnServiceMockRecorder) Reopen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockReturnService)(nil).Reopen), arg0, arg1)
+}
+
+// Request mocks base method.
+func (m *MockReturnService) Request(arg0 context.Context, arg1 model.ReturnRequestInput) (*shopify.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", arg0, arg1)
+	ret0, _ := ret[0].(*shopify.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockReturnServiceMockRecorder) Request(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockReturnService)(nil).Request), arg0, arg1)
+}
@@ -3,7 +3,6 @@ package shopify
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
 	"github.com/r0busta/graphql"
@@ -11,14 +10,27 @@ import (
 
 //go:generate mockgen -destination=./mock/order_service.go -package=mock . OrderService
 type OrderService interface {
-	Get(ctx context.Context, id graphql.ID) (*model.Order, error)
+	Get(ctx context.Context, id graphql.ID, fields ...FieldSelector) (*model.Order, error)
 
-	List(ctx context.Context, opts ListOptions) ([]model.Order, error)
-	ListAll(ctx context.Context) ([]model.Order, error)
+	List(ctx context.Context, opts ListOptions, fields ...FieldSelector) ([]model.Order, error)
+	ListAll(ctx context.Context, fields ...FieldSelector) ([]model.Order, error)
 
-	ListAfterCursor(ctx context.Context, opts ListOptions) ([]model.Order, *string, *string, error)
+	ListAfterCursor(ctx context.Context, opts ListOptions, fields ...FieldSelector) ([]model.Order, *string, *string, error)
+	Paginate(opts ListOptions, fields ...FieldSelector) *OrderPaginator
 
 	Update(ctx context.Context, input model.OrderInput) error
+	UpdateNote(ctx context.Context, id string, note string) error
+	AddTags(ctx context.Context, id string, tags []string) error
+	RemoveTags(ctx context.Context, id string, tags []string) error
+
+	Cancel(ctx context.Context, id string, opts OrderCancelOptions) (*model.Job, error)
+	Close(ctx context.Context, id string) error
+	Open(ctx context.Context, id string) error
+	MarkAsPaid(ctx context.Context, id string) error
+	Capture(ctx context.Context, input model.OrderCaptureInput) (*model.OrderTransaction, error)
+	SendInvoice(ctx context.Context, id string, email *model.EmailInput) error
+
+	BeginEdit(ctx context.Context, id string) (*OrderEdit, error)
 }
 
 type OrderServiceOp struct {
@@ -27,12 +39,64 @@ type OrderServiceOp struct {
 
 var _ OrderService = &OrderServiceOp{}
 
+// OrderPaginator pages through the orders.
+type OrderPaginator struct {
+	*Paginator[model.Order]
+}
+
+// OrderCancelOptions are the options of the order cancellation.
+type OrderCancelOptions struct {
+	// Reason defaults to OTHER.
+	Reason model.OrderCancelReason
+	// Refund refunds the order payments.
+	Refund bool
+	// Restock restocks the order line items.
+	Restock        bool
+	NotifyCustomer bool
+	StaffNote      string
+}
+
 type mutationOrderUpdate struct {
 	OrderUpdateResult struct {
 		UserErrors []model.UserError `json:"userErrors,omitempty"`
 	} `graphql:"orderUpdate(input: $input)" json:"orderUpdate"`
 }
 
+type mutationOrderCancel struct {
+	OrderCancelResult struct {
+		Job *struct {
+			ID   string `json:"id,omitempty"`
+			Done bool   `json:"done,omitempty"`
+		} `json:"job,omitempty"`
+
+		OrderCancelUserErrors []model.OrderCancelUserError `json:"orderCancelUserErrors,omitempty"`
+	} `graphql:"orderCancel(orderId: $orderId, reason: $reason, refund: $refund, restock: $restock, notifyCustomer: $notifyCustomer, staffNote: $staffNote)" json:"orderCancel"`
+}
+
+type mutationOrderClose struct {
+	OrderCloseResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"orderClose(input: $input)" json:"orderClose"`
+}
+
+type mutationOrderOpen struct {
+	OrderOpenResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"orderOpen(input: $input)" json:"orderOpen"`
+}
+
+type mutationOrderMarkAsPaid struct {
+	OrderMarkAsPaidResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"orderMarkAsPaid(input: $input)" json:"orderMarkAsPaid"`
+}
+
+type mutationOrderInvoiceSend struct {
+	OrderInvoiceSendResult struct {
+		UserErrors []model.OrderInvoiceSendUserError `json:"userErrors,omitempty"`
+	} `graphql:"orderInvoiceSend(id: $id, email: $email)" json:"orderInvoiceSend"`
+}
+
 const orderBaseQuery = `
 	id
 	legacyResourceId
@@ -140,6 +204,59 @@ const orderLightQuery = `
 	tags
 `
 
+var orderQuery = fmt.Sprintf(`
+	%s
+	lineItems(first:50){
+		edges{
+			node{
+				...lineItem
+			}
+		}
+	}
+	fulfillmentOrders(first:5){
+		edges {
+			node {
+				id
+				status
+				lineItems(first:50){
+					edges {
+						node {
+							id
+							remainingQuantity
+							totalQuantity
+							lineItem{
+								sku
+							}								
+						}
+					}
+				}
+			}
+		}
+	}
+`, orderBaseQuery)
+
+var orderBulkQuery = fmt.Sprintf(`
+	%s
+	lineItems{
+		edges{
+			node{
+				...lineItem
+			}
+		}
+	}
+`, orderBaseQuery)
+
+var orderListQuery = fmt.Sprintf(`
+	%s
+	lineItems(first:25){
+		edges{
+			node{
+				...lineItem
+			}
+		}
+	}
+`, orderLightQuery)
+
 const lineItemFragment = `
 fragment lineItem on LineItem {
 	id
@@ -218,45 +335,23 @@ fragment lineItem on LineItem {
 }
 `
 
-func (s *OrderServiceOp) Get(ctx context.Context, id graphql.ID) (*model.Order, error) {
+func (s *OrderServiceOp) Get(ctx context.Context, id graphql.ID, fields ...FieldSelector) (*model.Order, error) {
+	sel, err := selectFields(fields, "", false, selectionSet{fields: orderQuery, fragments: lineItemFragment})
+	if err != nil {
+		return nil, err
+	}
+
 	q := fmt.Sprintf(`
 		query order($id: ID!) {
 			node(id: $id){
 				... on Order {
 					%s
-					lineItems(first:50){
-						edges{
-							node{
-								...lineItem
-							}
-						}
-					}
-					fulfillmentOrders(first:5){
-						edges {
-							node {
-								id
-								status
-								lineItems(first:50){
-									edges {
-										node {
-											id
-											remainingQuantity
-											totalQuantity
-											lineItem{
-												sku
-											}								
-										}
-									}
-								}
-							}
-						}
-					}					
 				}
 			}
 		}
 
 		%s
-	`, orderBaseQuery, lineItemFragment)
+	`, sel.fields, sel.fragments)
 
 	vars := map[string]interface{}{
 		"id": id,
@@ -265,40 +360,40 @@ func (s *OrderServiceOp) Get(ctx context.Context, id graphql.ID) (*model.Order,
 	out := struct {
 		Order *model.Order `json:"node"`
 	}{}
-	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	err = s.client.gql.QueryString(ctx, q, vars, &out)
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
 
+	if out.Order == nil {
+		return nil, fmt.Errorf("order %v: %w", id, ErrNotFound)
+	}
+
 	return out.Order, nil
 }
 
-func (s *OrderServiceOp) List(ctx context.Context, opts ListOptions) ([]model.Order, error) {
+func (s *OrderServiceOp) List(ctx context.Context, opts ListOptions, fields ...FieldSelector) ([]model.Order, error) {
+	sel, err := selectFields(fields, "", true, selectionSet{fields: orderBulkQuery, fragments: lineItemFragment})
+	if err != nil {
+		return nil, err
+	}
+
 	q := fmt.Sprintf(`
 		{
-			orders(query: "$query"){
+			orders(query: %s){
 				edges{
 					node{
 						%s
-						lineItems{
-							edges{
-								node{
-									...lineItem
-								}
-							}
-						}
 					}
 				}
 			}
 		}
 
 		%s
-	`, orderBaseQuery, lineItemFragment)
-
-	q = strings.ReplaceAll(q, "$query", opts.Query)
+	`, graphQLString(opts.Query), sel.fields, sel.fragments)
 
 	res := []model.Order{}
-	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	err = s.client.BulkOperation.BulkQuery(ctx, q, &res)
 	if err != nil {
 		return nil, fmt.Errorf("bulk query: %w", err)
 	}
@@ -306,30 +401,28 @@ func (s *OrderServiceOp) List(ctx context.Context, opts ListOptions) ([]model.Or
 	return res, nil
 }
 
-func (s *OrderServiceOp) ListAll(ctx context.Context) ([]model.Order, error) {
+func (s *OrderServiceOp) ListAll(ctx context.Context, fields ...FieldSelector) ([]model.Order, error) {
+	sel, err := selectFields(fields, "", true, selectionSet{fields: orderBulkQuery, fragments: lineItemFragment})
+	if err != nil {
+		return nil, err
+	}
+
 	q := fmt.Sprintf(`
 		{
-			orders(query: "$query"){
+			orders{
 				edges{
 					node{
 						%s
-						lineItems{
-							edges{
-								node{
-									...lineItem
-								}
-							}
-						}
 					}
 				}
 			}
 		}
 
 		%s
-	`, orderBaseQuery, lineItemFragment)
+	`, sel.fields, sel.fragments)
 
 	res := []model.Order{}
-	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	err = s.client.BulkOperation.BulkQuery(ctx, q, &res)
 	if err != nil {
 		return nil, fmt.Errorf("bulk query: %w", err)
 	}
@@ -337,80 +430,49 @@ func (s *OrderServiceOp) ListAll(ctx context.Context) ([]model.Order, error) {
 	return res, nil
 }
 
-func (s *OrderServiceOp) ListAfterCursor(ctx context.Context, opts ListOptions) ([]model.Order, *string, *string, error) {
-	q := fmt.Sprintf(`
-		query orders($query: String, $first: Int, $last: Int, $before: String, $after: String, $reverse: Boolean) {
-			orders(query: $query, first: $first, last: $last, before: $before, after: $after, reverse: $reverse){
-				edges{
-					node{
-						%s
-
-						lineItems(first:25){
-							edges{
-								node{
-									...lineItem
-								}
-							}
-						}
-					}
-					cursor
-				}
-				pageInfo{
-					hasNextPage
-				}				
-			}
-		}
-
-		%s
-	`, orderLightQuery, lineItemFragmentLight)
-
-	vars := map[string]interface{}{
-		"query":   opts.Query,
-		"reverse": opts.Reverse,
-	}
-
+// ListAfterCursor returns a single page of the orders along with its first and last cursors.
+// Unlike Paginate, the page arguments are sent as set, without a default page size or shrinking the page on the query cost errors.
+func (s *OrderServiceOp) ListAfterCursor(ctx context.Context, opts ListOptions, fields ...FieldSelector) ([]model.Order, *string, *string, error) {
+	args := PageArgs{}
 	if opts.After != "" {
-		vars["after"] = opts.After
+		args.After = opts.After
 	} else if opts.Before != "" {
-		vars["before"] = opts.Before
+		args.Before = opts.Before
 	}
-
 	if opts.First > 0 {
-		vars["first"] = opts.First
+		args.First = opts.First
 	} else if opts.Last > 0 {
-		vars["last"] = opts.Last
+		args.Last = opts.Last
 	}
 
-	out := struct {
-		Orders struct {
-			Edges []struct {
-				OrderQueryResult *model.Order `json:"node,omitempty"`
-				Cursor           string       `json:"cursor,omitempty"`
-			} `json:"edges,omitempty"`
-			PageInfo struct {
-				HasNextPage bool `json:"hasNextPage,omitempty"`
-			} `json:"pageInfo,omitempty"`
-		} `json:"orders,omitempty"`
-	}{}
-	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	page, err := s.listPage(opts, fields...)(ctx, args)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("query: %w", err)
+		return nil, nil, nil, err
 	}
 
 	res := []model.Order{}
 	var firstCursor *string
 	var lastCursor *string
-	if len(out.Orders.Edges) > 0 {
-		firstCursor = &out.Orders.Edges[0].Cursor
-		lastCursor = &out.Orders.Edges[len(out.Orders.Edges)-1].Cursor
-		for _, o := range out.Orders.Edges {
-			res = append(res, *o.OrderQueryResult)
+	if len(page.Edges) > 0 {
+		firstCursor = &page.Edges[0].Cursor
+		lastCursor = &page.Edges[len(page.Edges)-1].Cursor
+		for _, e := range page.Edges {
+			res = append(res, e.Node)
 		}
 	}
 
 	return res, firstCursor, lastCursor, nil
 }
 
+func (s *OrderServiceOp) Paginate(opts ListOptions, fields ...FieldSelector) *OrderPaginator {
+	return &OrderPaginator{NewPaginator(opts, s.listPage(opts, fields...))}
+}
+
+// listPage returns the function fetching a page of the orders matching the query.
+func (s *OrderServiceOp) listPage(opts ListOptions, fields ...FieldSelector) PageFunc[model.Order] {
+	return listConnection[model.Order](s.client, "orders", opts, fields, selectionSet{fields: orderListQuery, fragments: lineItemFragmentLight})
+}
+
 func (s *OrderServiceOp) Update(ctx context.Context, input model.OrderInput) error {
 	m := mutationOrderUpdate{}
 
@@ -422,8 +484,181 @@ func (s *OrderServiceOp) Update(ctx context.Context, input model.OrderInput) err
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.OrderUpdateResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.OrderUpdateResult.UserErrors)
+	if err := newUserErrorsError(m.OrderUpdateResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *OrderServiceOp) UpdateNote(ctx context.Context, id string, note string) error {
+	return s.Update(ctx, model.OrderInput{
+		ID:   id,
+		Note: &note,
+	})
+}
+
+func (s *OrderServiceOp) AddTags(ctx context.Context, id string, tags []string) error {
+	return addTags(ctx, s.client, id, tags)
+}
+
+func (s *OrderServiceOp) RemoveTags(ctx context.Context, id string, tags []string) error {
+	return removeTags(ctx, s.client, id, tags)
+}
+
+// Cancel cancels the order asynchronously and returns the job doing it.
+func (s *OrderServiceOp) Cancel(ctx context.Context, id string, opts OrderCancelOptions) (*model.Job, error) {
+	m := mutationOrderCancel{}
+
+	reason := opts.Reason
+	if reason == "" {
+		reason = model.OrderCancelReasonOther
+	}
+
+	var staffNote *graphql.String
+	if opts.StaffNote != "" {
+		staffNote = graphql.NewString(graphql.String(opts.StaffNote))
+	}
+
+	vars := map[string]interface{}{
+		"orderId":        id,
+		"reason":         reason,
+		"refund":         graphql.Boolean(opts.Refund),
+		"restock":        graphql.Boolean(opts.Restock),
+		"notifyCustomer": graphql.NewBoolean(graphql.Boolean(opts.NotifyCustomer)),
+		"staffNote":      staffNote,
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.OrderCancelResult.OrderCancelUserErrors); err != nil {
+		return nil, err
+	}
+
+	if m.OrderCancelResult.Job == nil {
+		return nil, fmt.Errorf("order %s: no cancellation job", id)
+	}
+
+	return &model.Job{ID: m.OrderCancelResult.Job.ID, Done: m.OrderCancelResult.Job.Done}, nil
+}
+
+func (s *OrderServiceOp) Close(ctx context.Context, id string) error {
+	m := mutationOrderClose{}
+
+	vars := map[string]interface{}{
+		"input": model.OrderCloseInput{ID: id},
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.OrderCloseResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *OrderServiceOp) Open(ctx context.Context, id string) error {
+	m := mutationOrderOpen{}
+
+	vars := map[string]interface{}{
+		"input": model.OrderOpenInput{ID: id},
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.OrderOpenResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *OrderServiceOp) MarkAsPaid(ctx context.Context, id string) error {
+	m := mutationOrderMarkAsPaid{}
+
+	vars := map[string]interface{}{
+		"input": model.OrderMarkAsPaidInput{ID: id},
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.OrderMarkAsPaidResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Capture captures the authorized payment of the order and returns the capture transaction.
+func (s *OrderServiceOp) Capture(ctx context.Context, input model.OrderCaptureInput) (*model.OrderTransaction, error) {
+	m := `
+		mutation orderCapture($input: OrderCaptureInput!) {
+			orderCapture(input: $input){
+				transaction{
+					id
+					kind
+					status
+					gateway
+					test
+					processedAt
+					amountSet{
+						shopMoney{
+							amount
+							currencyCode
+						}
+					}
+				}
+				userErrors{
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	out := struct {
+		OrderCaptureResult model.OrderCapturePayload `json:"orderCapture"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.OrderCaptureResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return out.OrderCaptureResult.Transaction, nil
+}
+
+// SendInvoice emails the invoice of the order, to the customer email unless the email input overrides it.
+func (s *OrderServiceOp) SendInvoice(ctx context.Context, id string, email *model.EmailInput) error {
+	m := mutationOrderInvoiceSend{}
+
+	vars := map[string]interface{}{
+		"id":    id,
+		"email": email,
+	}
+	err := s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.OrderInvoiceSendResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
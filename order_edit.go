@@ -0,0 +1,388 @@
+package shopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+)
+
+var errOrderEditCommitted = errors.New("order edit already committed")
+
+// OrderEdit is an order editing session. The changes are staged on the calculated order and applied to the order on commit:
+//
+//	edit, err := client.Order.BeginEdit(ctx, orderID)
+//	if err != nil {
+//		return err
+//	}
+//	order, err := edit.
+//		AddVariant(ctx, variantID, 2).
+//		SetQuantity(ctx, lineItemID, 0, true).
+//		AddShippingLine(ctx, shippingLine).
+//		Commit(ctx, shopify.OrderEditCommitOptions{NotifyCustomer: true})
+//
+// The session stops staging changes after the first failure, which is returned by Err, Preview and Commit.
+type OrderEdit struct {
+	client *Client
+
+	calculatedOrder *model.CalculatedOrder
+	committed       bool
+	err             error
+}
+
+// OrderEditCustomItem is a line item which isn't a product variant.
+type OrderEditCustomItem struct {
+	Title            string
+	Price            model.MoneyInput
+	Quantity         int
+	RequiresShipping bool
+	Taxable          bool
+}
+
+// OrderEditCommitOptions are the options of the order edit commit.
+type OrderEditCommitOptions struct {
+	NotifyCustomer bool
+	StaffNote      string
+}
+
+// orderEditPayload is the payload of the mutations staging the changes.
+type orderEditPayload[E model.DisplayableError] struct {
+	CalculatedOrder *model.CalculatedOrder `json:"calculatedOrder,omitempty"`
+	UserErrors      []E                    `json:"userErrors,omitempty"`
+}
+
+const calculatedOrderQuery = `
+	id
+	committed
+	subtotalLineItemsQuantity
+	subtotalPriceSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	cartDiscountAmountSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	totalPriceSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	totalOutstandingSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	lineItems(first:250){
+		edges{
+			node{
+				...calculatedLineItem
+			}
+		}
+	}
+	addedLineItems(first:250){
+		edges{
+			node{
+				...calculatedLineItem
+			}
+		}
+	}
+	shippingLines{
+		id
+		title
+		stagedStatus
+		price{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+	}
+`
+
+const calculatedLineItemFragment = `
+fragment calculatedLineItem on CalculatedLineItem {
+	id
+	sku
+	title
+	variantTitle
+	quantity
+	editableQuantity
+	restockable
+	restocking
+	hasStagedLineItemDiscount
+	variant{
+		id
+		legacyResourceId
+	}
+	originalUnitPriceSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	discountedUnitPriceSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	editableSubtotalSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+}
+`
+
+// orderEditMutation returns the mutation document of the staging mutation, selecting the calculated order.
+func orderEditMutation(name string, definitions string, arguments string, userErrorFields string) string {
+	return fmt.Sprintf(`
+		mutation %[1]s($id: ID!, %[2]s) {
+			%[1]s(id: $id, %[3]s){
+				calculatedOrder{
+					%[4]s
+				}
+				userErrors{
+					field
+					message
+					%[5]s
+				}
+			}
+		}
+
+		%[6]s
+	`, name, definitions, arguments, calculatedOrderQuery, userErrorFields, calculatedLineItemFragment)
+}
+
+var (
+	orderEditAddVariantMutation = orderEditMutation("orderEditAddVariant",
+		"$variantId: ID!, $quantity: Int!, $allowDuplicates: Boolean",
+		"variantId: $variantId, quantity: $quantity, allowDuplicates: $allowDuplicates", "")
+	orderEditAddCustomItemMutation = orderEditMutation("orderEditAddCustomItem",
+		"$title: String!, $price: MoneyInput!, $quantity: Int!, $requiresShipping: Boolean, $taxable: Boolean",
+		"title: $title, price: $price, quantity: $quantity, requiresShipping: $requiresShipping, taxable: $taxable", "")
+	orderEditSetQuantityMutation = orderEditMutation("orderEditSetQuantity",
+		"$lineItemId: ID!, $quantity: Int!, $restock: Boolean",
+		"lineItemId: $lineItemId, quantity: $quantity, restock: $restock", "")
+	orderEditAddLineItemDiscountMutation = orderEditMutation("orderEditAddLineItemDiscount",
+		"$lineItemId: ID!, $discount: OrderEditAppliedDiscountInput!",
+		"lineItemId: $lineItemId, discount: $discount", "")
+	orderEditAddShippingLineMutation = orderEditMutation("orderEditAddShippingLine",
+		"$shippingLine: OrderEditAddShippingLineInput!",
+		"shippingLine: $shippingLine", "code")
+	orderEditUpdateShippingLineMutation = orderEditMutation("orderEditUpdateShippingLine",
+		"$shippingLineId: ID!, $shippingLine: OrderEditUpdateShippingLineInput!",
+		"shippingLineId: $shippingLineId, shippingLine: $shippingLine", "code")
+	orderEditRemoveShippingLineMutation = orderEditMutation("orderEditRemoveShippingLine",
+		"$shippingLineId: ID!",
+		"shippingLineId: $shippingLineId", "code")
+)
+
+// BeginEdit begins an editing session of the order.
+func (s *OrderServiceOp) BeginEdit(ctx context.Context, id string) (*OrderEdit, error) {
+	m := fmt.Sprintf(`
+		mutation orderEditBegin($id: ID!) {
+			orderEditBegin(id: $id){
+				calculatedOrder{
+					%s
+				}
+				userErrors{
+					field
+					message
+				}
+			}
+		}
+
+		%s
+	`, calculatedOrderQuery, calculatedLineItemFragment)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	out := struct {
+		OrderEditBeginResult orderEditPayload[model.UserError] `json:"orderEditBegin"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.OrderEditBeginResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	if out.OrderEditBeginResult.CalculatedOrder == nil {
+		return nil, fmt.Errorf("order %s: %w", id, ErrNotFound)
+	}
+
+	return &OrderEdit{client: s.client, calculatedOrder: out.OrderEditBeginResult.CalculatedOrder}, nil
+}
+
+// ID returns the ID of the calculated order.
+func (e *OrderEdit) ID() string {
+	return e.calculatedOrder.ID
+}
+
+// Err returns the failure which stopped the session, if any.
+func (e *OrderEdit) Err() error {
+	return e.err
+}
+
+// Preview returns the calculated order with the changes staged so far, including the resulting totals.
+func (e *OrderEdit) Preview() (*model.CalculatedOrder, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.calculatedOrder, nil
+}
+
+// AddVariant adds the quantity of the product variant as a new line item.
+func (e *OrderEdit) AddVariant(ctx context.Context, variantID string, quantity int) *OrderEdit {
+	return stageOrderEdit[model.UserError](ctx, e, "orderEditAddVariant", orderEditAddVariantMutation, map[string]interface{}{
+		"variantId": variantID,
+		"quantity":  quantity,
+	})
+}
+
+// AddCustomItem adds a line item which isn't a product variant.
+func (e *OrderEdit) AddCustomItem(ctx context.Context, item OrderEditCustomItem) *OrderEdit {
+	return stageOrderEdit[model.UserError](ctx, e, "orderEditAddCustomItem", orderEditAddCustomItemMutation, map[string]interface{}{
+		"title":            item.Title,
+		"price":            item.Price,
+		"quantity":         item.Quantity,
+		"requiresShipping": item.RequiresShipping,
+		"taxable":          item.Taxable,
+	})
+}
+
+// SetQuantity sets the quantity of the calculated line item, removing it if the quantity is 0.
+// The removed items are restocked if restock is true.
+func (e *OrderEdit) SetQuantity(ctx context.Context, lineItemID string, quantity int, restock bool) *OrderEdit {
+	return stageOrderEdit[model.UserError](ctx, e, "orderEditSetQuantity", orderEditSetQuantityMutation, map[string]interface{}{
+		"lineItemId": lineItemID,
+		"quantity":   quantity,
+		"restock":    restock,
+	})
+}
+
+// AddLineItemDiscount applies the discount to the line item added in the session.
+func (e *OrderEdit) AddLineItemDiscount(ctx context.Context, lineItemID string, discount model.OrderEditAppliedDiscountInput) *OrderEdit {
+	return stageOrderEdit[model.UserError](ctx, e, "orderEditAddLineItemDiscount", orderEditAddLineItemDiscountMutation, map[string]interface{}{
+		"lineItemId": lineItemID,
+		"discount":   discount,
+	})
+}
+
+// AddShippingLine adds a custom shipping line.
+func (e *OrderEdit) AddShippingLine(ctx context.Context, shippingLine model.OrderEditAddShippingLineInput) *OrderEdit {
+	return stageOrderEdit[model.OrderEditAddShippingLineUserError](ctx, e, "orderEditAddShippingLine", orderEditAddShippingLineMutation, map[string]interface{}{
+		"shippingLine": shippingLine,
+	})
+}
+
+// UpdateShippingLine updates the shipping line added in the session.
+func (e *OrderEdit) UpdateShippingLine(ctx context.Context, shippingLineID string, shippingLine model.OrderEditUpdateShippingLineInput) *OrderEdit {
+	return stageOrderEdit[model.OrderEditUpdateShippingLineUserError](ctx, e, "orderEditUpdateShippingLine", orderEditUpdateShippingLineMutation, map[string]interface{}{
+		"shippingLineId": shippingLineID,
+		"shippingLine":   shippingLine,
+	})
+}
+
+// RemoveShippingLine removes the shipping line of the order.
+func (e *OrderEdit) RemoveShippingLine(ctx context.Context, shippingLineID string) *OrderEdit {
+	return stageOrderEdit[model.OrderEditRemoveShippingLineUserError](ctx, e, "orderEditRemoveShippingLine", orderEditRemoveShippingLineMutation, map[string]interface{}{
+		"shippingLineId": shippingLineID,
+	})
+}
+
+// Commit applies the staged changes to the order and returns the updated order.
+func (e *OrderEdit) Commit(ctx context.Context, opts OrderEditCommitOptions) (*model.Order, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	if e.committed {
+		return nil, errOrderEditCommitted
+	}
+
+	m := fmt.Sprintf(`
+		mutation orderEditCommit($id: ID!, $notifyCustomer: Boolean, $staffNote: String) {
+			orderEditCommit(id: $id, notifyCustomer: $notifyCustomer, staffNote: $staffNote){
+				order{
+					%s
+				}
+				userErrors{
+					field
+					message
+				}
+			}
+		}
+
+		%s
+	`, orderQuery, lineItemFragment)
+
+	vars := map[string]interface{}{
+		"id":             e.calculatedOrder.ID,
+		"notifyCustomer": opts.NotifyCustomer,
+	}
+	if opts.StaffNote != "" {
+		vars["staffNote"] = opts.StaffNote
+	}
+
+	out := struct {
+		OrderEditCommitResult model.OrderEditCommitPayload `json:"orderEditCommit"`
+	}{}
+	err := e.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.OrderEditCommitResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	e.committed = true
+
+	return out.OrderEditCommitResult.Order, nil
+}
+
+// stageOrderEdit runs the mutation staging a change on the calculated order, unless the session has stopped.
+func stageOrderEdit[E model.DisplayableError](ctx context.Context, e *OrderEdit, name string, m string, vars map[string]interface{}) *OrderEdit {
+	if e.err != nil {
+		return e
+	}
+	if e.committed {
+		e.err = errOrderEditCommitted
+		return e
+	}
+
+	vars["id"] = e.calculatedOrder.ID
+
+	out := map[string]orderEditPayload[E]{}
+	err := e.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		e.err = fmt.Errorf("%s: mutation: %w", name, err)
+		return e
+	}
+
+	res := out[name]
+	if err := newUserErrorsError(res.UserErrors); err != nil {
+		e.err = fmt.Errorf("%s: %w", name, err)
+		return e
+	}
+
+	if res.CalculatedOrder != nil {
+		e.calculatedOrder = res.CalculatedOrder
+	}
+
+	return e
+}
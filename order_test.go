@@ -0,0 +1,82 @@
+package shopify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"github.com/r0busta/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderListAfterCursor(t *testing.T) {
+	gql := &fakeGraphQL{responses: []string{`{"orders":{"edges":[{"node":{"id":"gid://shopify/Order/1"},"cursor":"c1"},{"node":{"id":"gid://shopify/Order/2"},"cursor":"c2"}]}}`}}
+	c, err := NewClientE(WithGraphQLClient(gql), WithLogger(nil))
+	require.NoError(t, err)
+
+	orders, first, last, err := c.Order.ListAfterCursor(context.Background(), ListOptions{Query: "status:open", After: "c0"})
+	require.NoError(t, err)
+	assert.Len(t, orders, 2)
+	assert.Equal(t, "c1", *first)
+	assert.Equal(t, "c2", *last)
+	assert.Equal(t, "c0", gql.variables["after"])
+	assert.NotContains(t, gql.variables, "first", "no default page size is sent")
+
+	_, _, _, err = c.Order.ListAfterCursor(context.Background(), ListOptions{First: 300})
+	require.NoError(t, err)
+	assert.Equal(t, 300, gql.variables["first"], "the page size is sent as set")
+}
+
+func TestOrderCancel(t *testing.T) {
+	gql := &fakeGraphQL{responses: []string{`{"orderCancel":{"job":{"id":"gid://shopify/Job/1","done":false}}}`}}
+	c, err := NewClientE(WithGraphQLClient(gql), WithLogger(nil))
+	require.NoError(t, err)
+
+	job, err := c.Order.Cancel(context.Background(), "gid://shopify/Order/1", OrderCancelOptions{Reason: model.OrderCancelReasonCustomer, Restock: true})
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/Job/1", job.ID)
+	assert.Equal(t, model.OrderCancelReasonCustomer, gql.variables["reason"])
+	assert.Equal(t, graphql.Boolean(true), gql.variables["restock"])
+	assert.Nil(t, gql.variables["staffNote"])
+
+	gql.responses = []string{`{"orderCancel":{}}`}
+	_, err = c.Order.Cancel(context.Background(), "gid://shopify/Order/1", OrderCancelOptions{})
+	assert.Error(t, err, "a missing job is an error")
+	assert.Equal(t, model.OrderCancelReasonOther, gql.variables["reason"], "the reason defaults to OTHER")
+
+	gql.responses = []string{`{"orderCancel":{"orderCancelUserErrors":[{"field":["orderId"],"message":"Order not found","code":"NOT_FOUND"}]}}`}
+	_, err = c.Order.Cancel(context.Background(), "gid://shopify/Order/2", OrderCancelOptions{Reason: model.OrderCancelReasonOther})
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, "NOT_FOUND", userErrs.Errors[0].Code)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestOrderEdit(t *testing.T) {
+	gql := &fakeGraphQL{responses: []string{
+		`{"orderEditBegin":{"calculatedOrder":{"id":"gid://shopify/CalculatedOrder/1","subtotalLineItemsQuantity":1}}}`,
+		`{"orderEditAddVariant":{"calculatedOrder":{"id":"gid://shopify/CalculatedOrder/1","subtotalLineItemsQuantity":3}}}`,
+		`{"orderEditSetQuantity":{"userErrors":[{"field":["lineItemId"],"message":"Line item not found"}]}}`,
+	}}
+	c, err := NewClientE(WithGraphQLClient(gql), WithLogger(nil))
+	require.NoError(t, err)
+
+	edit, err := c.Order.BeginEdit(context.Background(), "gid://shopify/Order/1")
+	require.NoError(t, err)
+
+	edit.AddVariant(context.Background(), "gid://shopify/ProductVariant/1", 2)
+	preview, err := edit.Preview()
+	require.NoError(t, err)
+	assert.Equal(t, 3, preview.SubtotalLineItemsQuantity)
+	assert.Equal(t, "gid://shopify/CalculatedOrder/1", gql.variables["id"])
+
+	_, err = edit.
+		SetQuantity(context.Background(), "gid://shopify/CalculatedLineItem/2", 0, true).
+		AddVariant(context.Background(), "gid://shopify/ProductVariant/3", 1).
+		Commit(context.Background(), OrderEditCommitOptions{})
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, "Line item not found", userErrs.Errors[0].Message)
+	assert.Equal(t, "gid://shopify/CalculatedLineItem/2", gql.variables["lineItemId"], "the session stops after the failure")
+}
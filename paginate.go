@@ -0,0 +1,356 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 250
+
+	maxThrottledPageRetries = 3
+)
+
+// Connection is a page of a Relay connection.
+type Connection[T any] struct {
+	Edges    []Edge[T]      `json:"edges,omitempty"`
+	PageInfo model.PageInfo `json:"pageInfo"`
+}
+
+// Edge is an edge of a Relay connection.
+type Edge[T any] struct {
+	Node   T      `json:"node"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// PageArgs are the pagination arguments of a connection field, e.g. `orders(first: $first, after: $after)`.
+type PageArgs struct {
+	First  int
+	Last   int
+	After  string
+	Before string
+}
+
+// Variables adds the set arguments to the query variables as `first`, `last`, `after` and `before`.
+func (a PageArgs) Variables(vars map[string]interface{}) map[string]interface{} {
+	if vars == nil {
+		vars = map[string]interface{}{}
+	}
+	if a.First > 0 {
+		vars["first"] = a.First
+	}
+	if a.Last > 0 {
+		vars["last"] = a.Last
+	}
+	if a.After != "" {
+		vars["after"] = a.After
+	}
+	if a.Before != "" {
+		vars["before"] = a.Before
+	}
+	return vars
+}
+
+// PageFunc queries a page of the connection.
+type PageFunc[T any] func(ctx context.Context, args PageArgs) (*Connection[T], error)
+
+// Paginator iterates over the pages of a Relay connection. It pages forward from ListOptions.After by ListOptions.First
+// (50 by default) nodes, or backward from ListOptions.Before if ListOptions.Last is set.
+//
+// The page size is halved when a page exceeds the maximum query cost, and throttled pages are retried
+// once the throttle bucket has restored enough points.
+type Paginator[T any] struct {
+	fetch    PageFunc[T]
+	backward bool
+	pageSize int
+	cursor   string
+	done     bool
+
+	cost *graphqlclient.QueryCost
+}
+
+// NewPaginator creates a paginator querying the pages with the fetch function.
+func NewPaginator[T any](opts ListOptions, fetch PageFunc[T]) *Paginator[T] {
+	p := &Paginator[T]{
+		fetch:    fetch,
+		pageSize: opts.First,
+		cursor:   opts.After,
+	}
+	if opts.Last > 0 {
+		p.backward = true
+		p.pageSize = opts.Last
+		p.cursor = opts.Before
+	}
+	if p.pageSize <= 0 {
+		p.pageSize = defaultPageSize
+	}
+	p.pageSize = min(p.pageSize, maxPageSize)
+
+	return p
+}
+
+// Paginate iterates over all the nodes of the connection until an error, which ends the sequence.
+func Paginate[T any](ctx context.Context, opts ListOptions, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return NewPaginator(opts, fetch).All(ctx)
+}
+
+// HasNext reports whether there are more pages.
+func (p *Paginator[T]) HasNext() bool {
+	return !p.done
+}
+
+// Cursor returns the cursor to resume the pagination from, e.g. in ListOptions.After.
+func (p *Paginator[T]) Cursor() string {
+	return p.cursor
+}
+
+// NextPage queries the next page.
+func (p *Paginator[T]) NextPage(ctx context.Context) (*Connection[T], error) {
+	if p.done {
+		return &Connection[T]{}, nil
+	}
+
+	ctx = withCostObserver(ctx, func(cost *graphqlclient.QueryCost) {
+		p.cost = cost
+	})
+
+	throttled := 0
+	for {
+		p.cost = nil
+		page, err := p.fetch(ctx, p.args())
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrMaxCostExceeded) && p.pageSize > 1:
+			p.pageSize /= 2
+			continue
+		case errors.Is(err, ErrThrottled) && throttled < maxThrottledPageRetries:
+			throttled++
+			if err := sleepContext(ctx, p.throttleDelay()); err != nil {
+				return nil, err
+			}
+			continue
+		default:
+			return nil, err
+		}
+
+		p.advance(page)
+		return page, nil
+	}
+}
+
+// Next queries the nodes of the next page.
+func (p *Paginator[T]) Next(ctx context.Context) ([]T, error) {
+	page, err := p.NextPage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]T, 0, len(page.Edges))
+	for _, e := range page.Edges {
+		nodes = append(nodes, e.Node)
+	}
+	return nodes, nil
+}
+
+// All iterates over the nodes of the remaining pages until an error, which ends the sequence.
+// Breaking the loop stops querying the pages.
+func (p *Paginator[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.HasNext() {
+			page, err := p.NextPage(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, e := range page.Edges {
+				if !yield(e.Node, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *Paginator[T]) args() PageArgs {
+	if p.backward {
+		return PageArgs{Last: p.pageSize, Before: p.cursor}
+	}
+	return PageArgs{First: p.pageSize, After: p.cursor}
+}
+
+func (p *Paginator[T]) advance(page *Connection[T]) {
+	var more bool
+	var cursor *string
+	if p.backward {
+		more, cursor = page.PageInfo.HasPreviousPage, page.PageInfo.StartCursor
+		if cursor == nil && len(page.Edges) > 0 {
+			cursor = &page.Edges[0].Cursor
+		}
+	} else {
+		more, cursor = page.PageInfo.HasNextPage, page.PageInfo.EndCursor
+		if cursor == nil && len(page.Edges) > 0 {
+			cursor = &page.Edges[len(page.Edges)-1].Cursor
+		}
+	}
+
+	if !more || cursor == nil || *cursor == "" || len(page.Edges) == 0 {
+		p.done = true
+		return
+	}
+	p.cursor = *cursor
+
+	// Keep the next pages within the bucket capacity
+	if p.cost != nil && p.cost.ThrottleStatus.MaximumAvailable > 0 && p.cost.RequestedQueryCost > p.cost.ThrottleStatus.MaximumAvailable {
+		p.pageSize = max(1, p.pageSize/2)
+	}
+}
+
+// throttleDelay returns the time the throttle bucket needs to restore the points of the last requested page.
+func (p *Paginator[T]) throttleDelay() time.Duration {
+	if p.cost == nil || p.cost.ThrottleStatus.RestoreRate <= 0 {
+		return time.Second
+	}
+
+	missing := max(p.cost.RequestedQueryCost-p.cost.ThrottleStatus.CurrentlyAvailable, 0)
+	return time.Duration(missing / p.cost.ThrottleStatus.RestoreRate * float64(time.Second))
+}
+
+type costObserverKey struct{}
+
+// withCostObserver makes the GraphQL operations run with the context report their query cost to the function.
+func withCostObserver(ctx context.Context, fn func(cost *graphqlclient.QueryCost)) context.Context {
+	return context.WithValue(ctx, costObserverKey{}, fn)
+}
+
+func observeCost(ctx context.Context, cost *graphqlclient.QueryCost) {
+	if cost == nil {
+		return
+	}
+	if fn, ok := ctx.Value(costObserverKey{}).(func(cost *graphqlclient.QueryCost)); ok {
+		fn(cost)
+	}
+}
+
+// connectionQuery is a query of a connection field paged by the paginator.
+type connectionQuery struct {
+	// field is the path of the connection field, e.g. `orders` or `shop.metafields`.
+	field string
+	// params declares the filter variables, e.g. `$query: String`, passed to the connection field as the arguments of the same names.
+	params []string
+	// vars are the values of the filter variables.
+	vars map[string]interface{}
+	// fields selects the node fields, or def if it's empty.
+	fields []FieldSelector
+	def    selectionSet
+}
+
+// listConnection returns the function fetching the pages of the connection field of the query root matching the search query of the list options.
+func listConnection[T any](c *Client, field string, opts ListOptions, fields []FieldSelector, def selectionSet) PageFunc[T] {
+	return fetchConnection[T](c, opts, connectionQuery{
+		field:  field,
+		params: []string{"$query: String"},
+		vars:   map[string]interface{}{"query": opts.Query},
+		fields: fields,
+		def:    def,
+	})
+}
+
+// fetchConnection returns the function fetching the pages of the connection, in the order of the list options.
+func fetchConnection[T any](c *Client, opts ListOptions, cq connectionQuery) PageFunc[T] {
+	sel, selErr := selectFields(cq.fields, "", false, cq.def)
+	q := cq.document(sel)
+	path := strings.Split(cq.field, ".")
+
+	return func(ctx context.Context, args PageArgs) (*Connection[T], error) {
+		if selErr != nil {
+			return nil, selErr
+		}
+
+		vars := map[string]interface{}{
+			"reverse": opts.Reverse,
+		}
+		for k, v := range cq.vars {
+			vars[k] = v
+		}
+
+		var out map[string]json.RawMessage
+		err := c.gql.QueryString(ctx, q, args.Variables(vars), &out)
+		if err != nil {
+			return nil, fmt.Errorf("query: %w", err)
+		}
+
+		// A null parent, like a missing connection, is an empty page
+		for _, f := range path[:len(path)-1] {
+			var parent map[string]json.RawMessage
+			if data := out[f]; data != nil {
+				if err := json.Unmarshal(data, &parent); err != nil {
+					return nil, fmt.Errorf("decode %s: %w", f, err)
+				}
+			}
+			out = parent
+		}
+
+		page := &Connection[T]{}
+		if data := out[path[len(path)-1]]; data != nil {
+			if err := json.Unmarshal(data, page); err != nil {
+				return nil, fmt.Errorf("decode %s: %w", cq.field, err)
+			}
+		}
+
+		return page, nil
+	}
+}
+
+// document returns the query of the connection nodes with the selection, named after the field path, e.g. `shopMetafields`.
+func (cq connectionQuery) document(sel selectionSet) string {
+	path := strings.Split(cq.field, ".")
+
+	name := path[0]
+	for _, f := range path[1:] {
+		name += strings.ToUpper(f[:1]) + f[1:]
+	}
+
+	params := append(slices.Clip(cq.params), "$first: Int", "$last: Int", "$before: String", "$after: String", "$reverse: Boolean")
+	args := make([]string, 0, len(params))
+	for _, p := range params {
+		v, _, _ := strings.Cut(p, ":")
+		args = append(args, strings.TrimPrefix(v, "$")+": "+v)
+	}
+
+	field := fmt.Sprintf(`%s(%s){
+				edges{
+					node{
+						%s
+					}
+					cursor
+				}
+				pageInfo{
+					hasNextPage
+					hasPreviousPage
+					startCursor
+					endCursor
+				}
+			}`, path[len(path)-1], strings.Join(args, ", "), sel.fields)
+	for i := len(path) - 2; i >= 0; i-- {
+		field = path[i] + "{" + field + "}"
+	}
+
+	return fmt.Sprintf(`
+		query %s(%s) {
+			%s
+		}
+
+		%s
+	`, name, strings.Join(params, ", "), field, sel.fragments)
+}
@@ -0,0 +1,198 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConnection serves the pages of the nodes 0..n-1 with their index as the cursor.
+func fakeConnection(n int, calls *[]PageArgs) PageFunc[int] {
+	return func(ctx context.Context, args PageArgs) (*Connection[int], error) {
+		*calls = append(*calls, args)
+
+		start, end := 0, n
+		if args.After != "" {
+			fmt.Sscan(args.After, &start)
+			start++
+		}
+		if args.Before != "" {
+			fmt.Sscan(args.Before, &end)
+		}
+		if args.First > 0 {
+			end = min(end, start+args.First)
+		} else {
+			start = max(start, end-args.Last)
+		}
+
+		page := &Connection[int]{}
+		for i := start; i < end; i++ {
+			page.Edges = append(page.Edges, Edge[int]{Node: i, Cursor: fmt.Sprint(i)})
+		}
+		page.PageInfo = model.PageInfo{
+			HasNextPage:     end < n,
+			HasPreviousPage: start > 0,
+		}
+		if len(page.Edges) > 0 {
+			page.PageInfo.StartCursor = &page.Edges[0].Cursor
+			page.PageInfo.EndCursor = &page.Edges[len(page.Edges)-1].Cursor
+		}
+		return page, nil
+	}
+}
+
+func collect(t *testing.T, seq func(yield func(int, error) bool)) []int {
+	var out []int
+	for n, err := range seq {
+		require.NoError(t, err)
+		out = append(out, n)
+	}
+	return out
+}
+
+func TestPaginateForward(t *testing.T) {
+	var calls []PageArgs
+	got := collect(t, Paginate(context.Background(), ListOptions{First: 2, After: "0"}, fakeConnection(6, &calls)))
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, got)
+	assert.Equal(t, []PageArgs{{First: 2, After: "0"}, {First: 2, After: "2"}, {First: 2, After: "4"}}, calls)
+}
+
+func TestPaginateBackward(t *testing.T) {
+	var calls []PageArgs
+	got := collect(t, Paginate(context.Background(), ListOptions{Last: 2, Before: "5"}, fakeConnection(6, &calls)))
+
+	assert.Equal(t, []int{3, 4, 1, 2, 0}, got)
+	assert.Equal(t, []PageArgs{{Last: 2, Before: "5"}, {Last: 2, Before: "3"}, {Last: 2, Before: "1"}}, calls)
+}
+
+func TestPaginateEarlyTermination(t *testing.T) {
+	var calls []PageArgs
+	p := NewPaginator(ListOptions{}, fakeConnection(1000, &calls))
+	for n, err := range p.All(context.Background()) {
+		require.NoError(t, err)
+		if n == 60 {
+			break
+		}
+	}
+
+	assert.Len(t, calls, 2)
+	assert.Equal(t, defaultPageSize, calls[0].First)
+	assert.True(t, p.HasNext())
+	assert.Equal(t, "99", p.Cursor())
+}
+
+func TestPaginateMaxCostExceeded(t *testing.T) {
+	var calls []PageArgs
+	fetch := fakeConnection(10, &calls)
+	p := NewPaginator(ListOptions{First: 8}, func(ctx context.Context, args PageArgs) (*Connection[int], error) {
+		if args.First > 2 {
+			calls = append(calls, args)
+			return nil, &GraphQLError{Message: "Query cost is 1002", Code: "MAX_COST_EXCEEDED"}
+		}
+		return fetch(ctx, args)
+	})
+
+	got, err := p.Next(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, got)
+	assert.Equal(t, []PageArgs{{First: 8}, {First: 4}, {First: 2}}, calls)
+}
+
+func TestPaginateThrottled(t *testing.T) {
+	var calls []PageArgs
+	fetch := fakeConnection(3, &calls)
+	throttled := 2
+	p := NewPaginator(ListOptions{}, func(ctx context.Context, args PageArgs) (*Connection[int], error) {
+		observeCost(ctx, &graphqlclient.QueryCost{
+			RequestedQueryCost: 101,
+			ThrottleStatus: graphqlclient.ThrottleStatus{
+				MaximumAvailable:   1000,
+				CurrentlyAvailable: 100,
+				RestoreRate:        100,
+			},
+		})
+		if throttled > 0 {
+			throttled--
+			return nil, &GraphQLError{Message: "Throttled", Code: "THROTTLED"}
+		}
+		return fetch(ctx, args)
+	})
+
+	got := collect(t, p.All(context.Background()))
+	assert.Equal(t, []int{0, 1, 2}, got)
+}
+
+func TestPaginateError(t *testing.T) {
+	p := NewPaginator(ListOptions{}, func(ctx context.Context, args PageArgs) (*Connection[int], error) {
+		return nil, ErrAccessDenied
+	})
+
+	var errs []error
+	for _, err := range p.All(context.Background()) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrAccessDenied)
+}
+
+func TestListConnection(t *testing.T) {
+	gql := &fakeGraphQL{responses: []string{
+		`{"products":{"edges":[{"node":{"id":"gid://shopify/Product/1"},"cursor":"c1"}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}`,
+		`{"products":{"edges":[{"node":{"id":"gid://shopify/Product/2"},"cursor":"c2"}],"pageInfo":{"hasNextPage":false,"endCursor":"c2"}}}`,
+	}}
+	c, err := NewClientE(WithGraphQLClient(gql), WithLogger(nil))
+	require.NoError(t, err)
+
+	var ids []string
+	for p, err := range c.Product.Paginate(ListOptions{Query: "status:active", First: 1, Reverse: true}, Select("id")).All(context.Background()) {
+		require.NoError(t, err)
+		ids = append(ids, p.ID)
+	}
+	assert.Equal(t, []string{"gid://shopify/Product/1", "gid://shopify/Product/2"}, ids)
+
+	assert.Contains(t, gql.query, "query products($query: String, $first: Int, $last: Int, $before: String, $after: String, $reverse: Boolean)")
+	assert.Contains(t, gql.query, "products(query: $query, first: $first, last: $last, before: $before, after: $after, reverse: $reverse){")
+	assert.Equal(t, map[string]interface{}{"query": "status:active", "first": 1, "after": "c1", "reverse": true}, gql.variables)
+}
+
+func TestFetchConnectionNested(t *testing.T) {
+	gql := &fakeGraphQL{responses: []string{`{"shop":{"metafields":{"edges":[{"node":{"id":"gid://shopify/Metafield/1","key":"k"},"cursor":"c1"}],"pageInfo":{"hasNextPage":false}}}}`}}
+	c, err := NewClientE(WithGraphQLClient(gql), WithLogger(nil))
+	require.NoError(t, err)
+
+	metafields, err := c.Metafield.PaginateShopMetafields("custom", ListOptions{}).Next(context.Background())
+	require.NoError(t, err)
+	require.Len(t, metafields, 1)
+	assert.Equal(t, "k", metafields[0].Key)
+
+	assert.Contains(t, gql.query, "query shopMetafields($namespace: String, $first: Int")
+	assert.Contains(t, gql.query, "shop{metafields(namespace: $namespace, first: $first")
+	assert.Equal(t, map[string]interface{}{"namespace": "custom", "first": defaultPageSize, "reverse": false}, gql.variables)
+}
+
+func TestFetchConnectionNullParent(t *testing.T) {
+	gql := &fakeGraphQL{responses: []string{`{"shop":null}`}}
+	c, err := NewClientE(WithGraphQLClient(gql), WithLogger(nil))
+	require.NoError(t, err)
+
+	p := c.Metafield.PaginateShopMetafields("", ListOptions{})
+	metafields, err := p.Next(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, metafields)
+	assert.False(t, p.HasNext())
+	assert.NotContains(t, gql.variables, "namespace")
+}
+
+func TestFetchConnectionInvalidSelection(t *testing.T) {
+	c, err := NewClientE(WithGraphQLClient(&fakeGraphQL{}), WithLogger(nil))
+	require.NoError(t, err)
+
+	_, err = c.Customer.Paginate(ListOptions{}, Fragment("not a fragment")).Next(context.Background())
+	assert.Error(t, err)
+}
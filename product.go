@@ -3,17 +3,17 @@ package shopify
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
 )
 
 //go:generate mockgen -destination=./mock/product_service.go -package=mock . ProductService
 type ProductService interface {
-	List(ctx context.Context, query string) ([]model.Product, error)
-	ListAll(ctx context.Context) ([]model.Product, error)
+	List(ctx context.Context, query string, fields ...FieldSelector) ([]model.Product, error)
+	ListAll(ctx context.Context, fields ...FieldSelector) ([]model.Product, error)
+	Paginate(opts ListOptions, fields ...FieldSelector) *ProductPaginator
 
-	Get(ctx context.Context, id string) (*model.Product, error)
+	Get(ctx context.Context, id string, fields ...FieldSelector) (*model.Product, error)
 
 	Create(ctx context.Context, product model.ProductCreateInput, media []model.CreateMediaInput) (*string, error)
 
@@ -34,6 +34,11 @@ type ProductServiceOp struct {
 
 var _ ProductService = &ProductServiceOp{}
 
+// ProductPaginator pages through the products.
+type ProductPaginator struct {
+	*Paginator[model.Product]
+}
+
 type mutationProductCreate struct {
 	ProductCreateResult struct {
 		Product *struct {
@@ -58,28 +63,30 @@ type mutationProductDelete struct {
 
 type mutationProductVariantsBulkCreate struct {
 	ProductVariantsBulkCreateResult struct {
-		UserErrors []model.UserError `json:"userErrors,omitempty"`
+		UserErrors []model.ProductVariantsBulkCreateUserError `json:"userErrors,omitempty"`
 	} `graphql:"productVariantsBulkCreate(productId: $productId, variants: $variants, strategy: $strategy)" json:"productVariantsBulkCreate"`
 }
 
 type mutationProductVariantsBulkUpdate struct {
 	ProductVariantsBulkUpdateResult struct {
-		UserErrors []model.UserError `json:"userErrors,omitempty"`
+		UserErrors []model.ProductVariantsBulkUpdateUserError `json:"userErrors,omitempty"`
 	} `graphql:"productVariantsBulkUpdate(productId: $productId, variants: $variants)" json:"productVariantsBulkUpdate"`
 }
 
 type mutationProductVariantsBulkReorder struct {
 	ProductVariantsBulkReorderResult struct {
-		UserErrors []model.UserError `json:"userErrors,omitempty"`
+		UserErrors []model.ProductVariantsBulkReorderUserError `json:"userErrors,omitempty"`
 	} `graphql:"productVariantsBulkReorder(positions: $positions, productId: $productId)" json:"productVariantsBulkReorder"`
 }
 
 type mutationProductCreateMedia struct {
 	ProductCreateMediaResult struct {
-		MediaUserErrors []model.UserError `json:"mediaUserErrors,omitempty"`
+		MediaUserErrors []model.MediaUserError `json:"mediaUserErrors,omitempty"`
 	} `graphql:"productCreateMedia(productId: $productId, media: $media)" json:"productCreateMedia"`
 }
 
+const productVariantsPageSize = 100
+
 const productBaseQuery = `
 	id
 	legacyResourceId
@@ -126,7 +133,7 @@ const productBaseQuery = `
 
 var productQuery = fmt.Sprintf(`
 	%s
-	variants(first:100, after: $cursor){
+	variants(first: $first, after: $after){
 		edges{
 			node{
 				id
@@ -160,9 +167,11 @@ var productQuery = fmt.Sprintf(`
 				}
 				availableForSale
 			}
+			cursor
 		}
 		pageInfo{
 			hasNextPage
+			endCursor
 		}
 	}
 `, productBaseQuery)
@@ -219,7 +228,12 @@ var productBulkQuery = fmt.Sprintf(`
 	}
 `, productBaseQuery)
 
-func (s *ProductServiceOp) ListAll(ctx context.Context) ([]model.Product, error) {
+func (s *ProductServiceOp) ListAll(ctx context.Context, fields ...FieldSelector) ([]model.Product, error) {
+	sel, err := selectFields(fields, "", true, selectionSet{fields: productBulkQuery})
+	if err != nil {
+		return nil, err
+	}
+
 	q := fmt.Sprintf(`
 		{
 			products{
@@ -230,10 +244,12 @@ func (s *ProductServiceOp) ListAll(ctx context.Context) ([]model.Product, error)
 				}
 			}
 		}
-	`, productBulkQuery)
+
+		%s
+	`, sel.fields, sel.fragments)
 
 	res := []model.Product{}
-	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	err = s.client.BulkOperation.BulkQuery(ctx, q, &res)
 	if err != nil {
 		return []model.Product{}, err
 	}
@@ -241,10 +257,15 @@ func (s *ProductServiceOp) ListAll(ctx context.Context) ([]model.Product, error)
 	return res, nil
 }
 
-func (s *ProductServiceOp) List(ctx context.Context, query string) ([]model.Product, error) {
+func (s *ProductServiceOp) List(ctx context.Context, query string, fields ...FieldSelector) ([]model.Product, error) {
+	sel, err := selectFields(fields, "", true, selectionSet{fields: productBulkQuery})
+	if err != nil {
+		return nil, err
+	}
+
 	q := fmt.Sprintf(`
 		{
-			products(query: "$query"){
+			products(query: %s){
 				edges{
 					node{
 						%s
@@ -252,12 +273,12 @@ func (s *ProductServiceOp) List(ctx context.Context, query string) ([]model.Prod
 				}
 			}
 		}
-	`, productBulkQuery)
 
-	q = strings.ReplaceAll(q, "$query", query)
+		%s
+	`, graphQLString(query), sel.fields, sel.fragments)
 
 	res := []model.Product{}
-	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	err = s.client.BulkOperation.BulkQuery(ctx, q, &res)
 	if err != nil {
 		return nil, fmt.Errorf("bulk query: %w", err)
 	}
@@ -265,54 +286,99 @@ func (s *ProductServiceOp) List(ctx context.Context, query string) ([]model.Prod
 	return res, nil
 }
 
-func (s *ProductServiceOp) Get(ctx context.Context, id string) (*model.Product, error) {
-	out, err := s.getPage(ctx, id, "")
+func (s *ProductServiceOp) Get(ctx context.Context, id string, fields ...FieldSelector) (*model.Product, error) {
+	sel, err := selectFields(fields, "variants", false, selectionSet{fields: productQuery, paged: true})
 	if err != nil {
 		return nil, err
 	}
 
-	nextPageData := out
-	hasNextPage := out.Variants.PageInfo.HasNextPage
-	for hasNextPage && len(nextPageData.Variants.Edges) > 0 {
-		cursor := nextPageData.Variants.Edges[len(nextPageData.Variants.Edges)-1].Cursor
-		nextPageData, err := s.getPage(ctx, id, cursor)
+	q := fmt.Sprintf(`
+		query product($id: ID!%s) {
+			product(id: $id){
+				%s
+			}
+		}
+
+		%s
+	`, sel.pageVariables(), sel.fields, sel.fragments)
+
+	if !sel.paged {
+		out := struct {
+			Product *model.Product `json:"product"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, map[string]interface{}{"id": id}, &out)
 		if err != nil {
-			return nil, fmt.Errorf("get page: %w", err)
+			return nil, fmt.Errorf("query: %w", err)
+		}
+		if out.Product == nil {
+			return nil, fmt.Errorf("product %s: %w", id, ErrNotFound)
 		}
-		out.Variants.Edges = append(out.Variants.Edges, nextPageData.Variants.Edges...)
-		hasNextPage = nextPageData.Variants.PageInfo.HasNextPage
+		return out.Product, nil
 	}
 
-	return out, nil
-}
+	var product *model.Product
+	variants := NewPaginator(ListOptions{First: productVariantsPageSize}, func(ctx context.Context, args PageArgs) (*Connection[*model.ProductVariant], error) {
+		page, err := s.getPage(ctx, q, id, args)
+		if err != nil {
+			return nil, err
+		}
+		if product == nil {
+			product = page.Product
+		}
+		return &page.Variants, nil
+	})
 
-func (s *ProductServiceOp) getPage(ctx context.Context, id string, cursor string) (*model.Product, error) {
-	q := fmt.Sprintf(`
-		query product($id: ID!, $cursor: String) {
-			product(id: $id){
-				%s
+	edges := []model.ProductVariantEdge{}
+	for variants.HasNext() {
+		page, err := variants.NextPage(ctx)
+		if err != nil {
+			if product == nil {
+				return nil, err
 			}
+			return nil, fmt.Errorf("get page: %w", err)
+		}
+		for _, e := range page.Edges {
+			edges = append(edges, model.ProductVariantEdge{Cursor: e.Cursor, Node: e.Node})
 		}
-	`, productQuery)
-
-	vars := map[string]interface{}{
-		"id": id,
 	}
-	if cursor != "" {
-		vars["cursor"] = cursor
+	product.Variants = &model.ProductVariantConnection{
+		Edges:    edges,
+		PageInfo: &model.PageInfo{},
 	}
 
+	return product, nil
+}
+
+// productPage is a product along with a page of its variants.
+type productPage struct {
+	*model.Product
+	Variants Connection[*model.ProductVariant] `json:"variants"`
+}
+
+func (s *ProductServiceOp) getPage(ctx context.Context, q string, id string, args PageArgs) (*productPage, error) {
+	vars := args.Variables(map[string]interface{}{
+		"id": id,
+	})
+
 	out := struct {
-		Product *model.Product `json:"product"`
+		Product *productPage `json:"product"`
 	}{}
 	err := s.client.gql.QueryString(ctx, q, vars, &out)
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
 
+	if out.Product == nil || out.Product.Product == nil {
+		return nil, fmt.Errorf("product %s: %w", id, ErrNotFound)
+	}
+
 	return out.Product, nil
 }
 
+func (s *ProductServiceOp) Paginate(opts ListOptions, fields ...FieldSelector) *ProductPaginator {
+	return &ProductPaginator{NewPaginator(opts, listConnection[model.Product](s.client, "products", opts, fields, selectionSet{fields: productBaseQuery}))}
+}
+
 func (s *ProductServiceOp) Create(ctx context.Context, product model.ProductCreateInput, media []model.CreateMediaInput) (*string, error) {
 	m := mutationProductCreate{}
 
@@ -326,8 +392,8 @@ func (s *ProductServiceOp) Create(ctx context.Context, product model.ProductCrea
 		return nil, fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.ProductCreateResult.UserErrors) > 0 {
-		return nil, fmt.Errorf("%+v", m.ProductCreateResult.UserErrors)
+	if err := newUserErrorsError(m.ProductCreateResult.UserErrors); err != nil {
+		return nil, err
 	}
 
 	return &m.ProductCreateResult.Product.ID, nil
@@ -345,8 +411,8 @@ func (s *ProductServiceOp) Update(ctx context.Context, product model.ProductUpda
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.ProductUpdateResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.ProductUpdateResult.UserErrors)
+	if err := newUserErrorsError(m.ProductUpdateResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -363,8 +429,8 @@ func (s *ProductServiceOp) Delete(ctx context.Context, product model.ProductDele
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.ProductDeleteResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.ProductDeleteResult.UserErrors)
+	if err := newUserErrorsError(m.ProductDeleteResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -383,8 +449,8 @@ func (s *ProductServiceOp) VariantsBulkCreate(ctx context.Context, id string, in
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.ProductVariantsBulkCreateResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.ProductVariantsBulkCreateResult.UserErrors)
+	if err := newUserErrorsError(m.ProductVariantsBulkCreateResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -402,8 +468,8 @@ func (s *ProductServiceOp) VariantsBulkUpdate(ctx context.Context, id string, in
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.ProductVariantsBulkUpdateResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.ProductVariantsBulkUpdateResult.UserErrors)
+	if err := newUserErrorsError(m.ProductVariantsBulkUpdateResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -421,8 +487,8 @@ func (s *ProductServiceOp) VariantsBulkReorder(ctx context.Context, id string, i
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.ProductVariantsBulkReorderResult.UserErrors) > 0 {
-		return fmt.Errorf("%+v", m.ProductVariantsBulkReorderResult.UserErrors)
+	if err := newUserErrorsError(m.ProductVariantsBulkReorderResult.UserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -441,8 +507,8 @@ func (s *ProductServiceOp) MediaCreate(ctx context.Context, id string, input []m
 		return fmt.Errorf("mutation: %w", err)
 	}
 
-	if len(m.ProductCreateMediaResult.MediaUserErrors) > 0 {
-		return fmt.Errorf("%+v", m.ProductCreateMediaResult.MediaUserErrors)
+	if err := newUserErrorsError(m.ProductCreateMediaResult.MediaUserErrors); err != nil {
+		return err
 	}
 
 	return nil
@@ -0,0 +1,293 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+)
+
+//go:generate mockgen -destination=./mock/refund_service.go -package=mock . RefundService
+type RefundService interface {
+	Get(ctx context.Context, id string) (*model.Refund, error)
+	Suggest(ctx context.Context, orderID string, opts SuggestedRefundOptions) (*model.SuggestedRefund, error)
+	Create(ctx context.Context, input model.RefundInput) (*model.Refund, error)
+}
+
+type RefundServiceOp struct {
+	client *Client
+}
+
+var _ RefundService = &RefundServiceOp{}
+
+// SuggestedRefundOptions are the items of the order to calculate the suggested refund of.
+type SuggestedRefundOptions struct {
+	RefundLineItems []model.RefundLineItemInput
+	RefundDuties    []model.RefundDutyInput
+	// RefundShipping refunds the full shipping, otherwise ShippingAmount is refunded, if set.
+	RefundShipping bool
+	ShippingAmount string
+	// SuggestFullRefund suggests refunding the full order, ignoring the line items.
+	SuggestFullRefund bool
+}
+
+const refundQuery = `
+	id
+	legacyResourceId
+	createdAt
+	note
+	totalRefundedSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	refundLineItems(first:250){
+		edges{
+			node{
+				quantity
+				restockType
+				restocked
+				location{
+					id
+				}
+				lineItem{
+					id
+					sku
+					title
+				}
+				priceSet{
+					shopMoney{
+						amount
+						currencyCode
+					}
+				}
+				subtotalSet{
+					shopMoney{
+						amount
+						currencyCode
+					}
+				}
+				totalTaxSet{
+					shopMoney{
+						amount
+						currencyCode
+					}
+				}
+			}
+		}
+	}
+	transactions(first:50){
+		edges{
+			node{
+				id
+				kind
+				status
+				gateway
+				test
+				processedAt
+				amountSet{
+					shopMoney{
+						amount
+						currencyCode
+					}
+				}
+			}
+		}
+	}
+`
+
+const suggestedRefundQuery = `
+	amountSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	subtotalSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	totalTaxSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	maximumRefundableSet{
+		shopMoney{
+			amount
+			currencyCode
+		}
+	}
+	shipping{
+		amountSet{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+		taxSet{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+		maximumRefundableSet{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+	}
+	refundLineItems{
+		quantity
+		restockType
+		location{
+			id
+		}
+		lineItem{
+			id
+			sku
+			title
+		}
+		subtotalSet{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+		totalTaxSet{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+	}
+	suggestedTransactions{
+		gateway
+		kind
+		parentTransaction{
+			id
+		}
+		amountSet{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+		maximumRefundableSet{
+			shopMoney{
+				amount
+				currencyCode
+			}
+		}
+	}
+`
+
+func (s *RefundServiceOp) Get(ctx context.Context, id string) (*model.Refund, error) {
+	q := fmt.Sprintf(`
+		query refund($id: ID!) {
+			refund(id: $id){
+				%s
+			}
+		}
+	`, refundQuery)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	out := struct {
+		Refund *model.Refund `json:"refund"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	if out.Refund == nil {
+		return nil, fmt.Errorf("refund %s: %w", id, ErrNotFound)
+	}
+
+	return out.Refund, nil
+}
+
+// Suggest calculates the refund of the order items, including the taxes, the shipping and the transactions to refund them with,
+// which can be passed to Create.
+func (s *RefundServiceOp) Suggest(ctx context.Context, orderID string, opts SuggestedRefundOptions) (*model.SuggestedRefund, error) {
+	q := fmt.Sprintf(`
+		query suggestedRefund($id: ID!, $refundLineItems: [RefundLineItemInput!], $refundDuties: [RefundDutyInput!], $refundShipping: Boolean, $shippingAmount: Money, $suggestFullRefund: Boolean) {
+			order(id: $id){
+				suggestedRefund(refundLineItems: $refundLineItems, refundDuties: $refundDuties, refundShipping: $refundShipping, shippingAmount: $shippingAmount, suggestFullRefund: $suggestFullRefund){
+					%s
+				}
+			}
+		}
+	`, suggestedRefundQuery)
+
+	vars := map[string]interface{}{
+		"id":                orderID,
+		"refundLineItems":   opts.RefundLineItems,
+		"refundDuties":      opts.RefundDuties,
+		"refundShipping":    opts.RefundShipping,
+		"suggestFullRefund": opts.SuggestFullRefund,
+	}
+	if opts.ShippingAmount != "" {
+		vars["shippingAmount"] = opts.ShippingAmount
+	}
+
+	out := struct {
+		Order *struct {
+			SuggestedRefund *model.SuggestedRefund `json:"suggestedRefund"`
+		} `json:"order"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	if out.Order == nil {
+		return nil, fmt.Errorf("order %s: %w", orderID, ErrNotFound)
+	}
+
+	return out.Order.SuggestedRefund, nil
+}
+
+// Create refunds the line items, the duties and the shipping of the order, restocking the line items at their locations,
+// with the given transactions.
+func (s *RefundServiceOp) Create(ctx context.Context, input model.RefundInput) (*model.Refund, error) {
+	m := fmt.Sprintf(`
+		mutation refundCreate($input: RefundInput!) {
+			refundCreate(input: $input){
+				refund{
+					%s
+				}
+				userErrors{
+					field
+					message
+				}
+			}
+		}
+	`, refundQuery)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	out := struct {
+		RefundCreateResult model.RefundCreatePayload `json:"refundCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.RefundCreateResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return out.RefundCreateResult.Refund, nil
+}
@@ -0,0 +1,128 @@
+package shopify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestRefundGet(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"refund":{"id":"gid://shopify/Refund/1","note":"Damaged","refundLineItems":{"edges":[{"node":{"quantity":1,"restockType":"RETURN","location":{"id":"gid://shopify/Location/1"}}}]}}}`,
+		`{"refund":null}`,
+	)
+	c := srv.client(t)
+
+	refund, err := c.Refund.Get(context.Background(), "gid://shopify/Refund/1")
+	require.NoError(t, err)
+	assert.Equal(t, "Damaged", *refund.Note)
+	require.Len(t, refund.RefundLineItems.Edges, 1)
+	assert.Equal(t, model.RefundLineItemRestockTypeReturn, refund.RefundLineItems.Edges[0].Node.RestockType)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "query refund($id: ID!)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/Refund/1"}, req.Variables)
+
+	_, err = c.Refund.Get(context.Background(), "gid://shopify/Refund/2")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRefundSuggest(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"order":{"suggestedRefund":{
+			"amountSet":{"shopMoney":{"amount":"24.90","currencyCode":"EUR"}},
+			"suggestedTransactions":[{"gateway":"shopify_payments","kind":"SUGGESTED_REFUND","parentTransaction":{"id":"gid://shopify/OrderTransaction/1"},"amountSet":{"shopMoney":{"amount":"24.90","currencyCode":"EUR"}}}]
+		}}}`,
+		`{"order":{"suggestedRefund":{"amountSet":{"shopMoney":{"amount":"99.80","currencyCode":"EUR"}}}}}`,
+		`{"order":null}`,
+	)
+	c := srv.client(t)
+
+	suggested, err := c.Refund.Suggest(context.Background(), "gid://shopify/Order/1", SuggestedRefundOptions{
+		RefundLineItems: []model.RefundLineItemInput{
+			{LineItemID: "gid://shopify/LineItem/1", Quantity: 1, RestockType: ptr(model.RefundLineItemRestockTypeReturn), LocationID: ptr("gid://shopify/Location/1")},
+		},
+		ShippingAmount: "4.95",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "24.90", suggested.AmountSet.ShopMoney.Amount.String)
+	require.Len(t, suggested.SuggestedTransactions, 1)
+	assert.Equal(t, "gid://shopify/OrderTransaction/1", suggested.SuggestedTransactions[0].ParentTransaction.ID)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "query suggestedRefund($id: ID!, $refundLineItems: [RefundLineItemInput!], $refundDuties: [RefundDutyInput!], $refundShipping: Boolean, $shippingAmount: Money, $suggestFullRefund: Boolean)")
+	assert.Contains(t, req.Query, "suggestedRefund(refundLineItems: $refundLineItems, refundDuties: $refundDuties, refundShipping: $refundShipping, shippingAmount: $shippingAmount, suggestFullRefund: $suggestFullRefund)")
+	assert.Equal(t, map[string]interface{}{
+		"id": "gid://shopify/Order/1",
+		"refundLineItems": []interface{}{
+			map[string]interface{}{"lineItemId": "gid://shopify/LineItem/1", "quantity": float64(1), "restockType": "RETURN", "locationId": "gid://shopify/Location/1"},
+		},
+		"refundDuties":      nil,
+		"refundShipping":    false,
+		"shippingAmount":    "4.95",
+		"suggestFullRefund": false,
+	}, req.Variables)
+
+	suggested, err = c.Refund.Suggest(context.Background(), "gid://shopify/Order/1", SuggestedRefundOptions{RefundShipping: true, SuggestFullRefund: true})
+	require.NoError(t, err)
+	assert.Equal(t, "99.80", suggested.AmountSet.ShopMoney.Amount.String)
+	req = srv.last(t)
+	assert.Equal(t, true, req.Variables["refundShipping"])
+	assert.Equal(t, true, req.Variables["suggestFullRefund"])
+	assert.NotContains(t, req.Variables, "shippingAmount", "the full shipping is refunded")
+
+	_, err = c.Refund.Suggest(context.Background(), "gid://shopify/Order/2", SuggestedRefundOptions{SuggestFullRefund: true})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRefundCreate(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"refundCreate":{"refund":{"id":"gid://shopify/Refund/1","totalRefundedSet":{"shopMoney":{"amount":"29.85","currencyCode":"EUR"}}}}}`,
+		`{"refundCreate":{"userErrors":[{"field":["transactions","0","amount"],"message":"Amount exceeds the refundable amount"}]}}`,
+	)
+	c := srv.client(t)
+
+	input := model.RefundInput{
+		OrderID: "gid://shopify/Order/1",
+		Note:    ptr("Damaged in transit"),
+		Notify:  ptr(true),
+		RefundLineItems: []model.RefundLineItemInput{
+			{LineItemID: "gid://shopify/LineItem/1", Quantity: 1, RestockType: ptr(model.RefundLineItemRestockTypeReturn), LocationID: ptr("gid://shopify/Location/1")},
+			{LineItemID: "gid://shopify/LineItem/2", Quantity: 2, RestockType: ptr(model.RefundLineItemRestockTypeNoRestock)},
+		},
+		Shipping: &model.ShippingRefundInput{Amount: ptr(null.StringFrom("4.95"))},
+		Transactions: []model.OrderTransactionInput{
+			{OrderID: "gid://shopify/Order/1", ParentID: ptr("gid://shopify/OrderTransaction/1"), Gateway: "shopify_payments", Kind: model.OrderTransactionKindRefund, Amount: null.StringFrom("29.85")},
+		},
+	}
+	refund, err := c.Refund.Create(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/Refund/1", refund.ID)
+	assert.Equal(t, "29.85", refund.TotalRefundedSet.ShopMoney.Amount.String)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation refundCreate($input: RefundInput!)")
+	assert.Contains(t, req.Query, "refundCreate(input: $input)")
+	assert.Equal(t, map[string]interface{}{
+		"orderId": "gid://shopify/Order/1",
+		"note":    "Damaged in transit",
+		"notify":  true,
+		"refundLineItems": []interface{}{
+			map[string]interface{}{"lineItemId": "gid://shopify/LineItem/1", "quantity": float64(1), "restockType": "RETURN", "locationId": "gid://shopify/Location/1"},
+			map[string]interface{}{"lineItemId": "gid://shopify/LineItem/2", "quantity": float64(2), "restockType": "NO_RESTOCK"},
+		},
+		"shipping": map[string]interface{}{"amount": "4.95"},
+		"transactions": []interface{}{
+			map[string]interface{}{"orderId": "gid://shopify/Order/1", "parentId": "gid://shopify/OrderTransaction/1", "gateway": "shopify_payments", "kind": "REFUND", "amount": "29.85"},
+		},
+	}, req.Variables["input"])
+
+	_, err = c.Refund.Create(context.Background(), input)
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, []string{"transactions", "0", "amount"}, userErrs.Errors[0].Field)
+}
@@ -0,0 +1,311 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+)
+
+//go:generate mockgen -destination=./mock/return_service.go -package=mock . ReturnService
+type ReturnService interface {
+	Get(ctx context.Context, id string) (*Return, error)
+
+	Request(ctx context.Context, input model.ReturnRequestInput) (*Return, error)
+	Approve(ctx context.Context, input model.ReturnApproveRequestInput) (*Return, error)
+	Decline(ctx context.Context, input model.ReturnDeclineRequestInput) (*Return, error)
+	Create(ctx context.Context, input model.ReturnInput) (*Return, error)
+
+	Close(ctx context.Context, id string) (*Return, error)
+	Reopen(ctx context.Context, id string) (*Return, error)
+	Cancel(ctx context.Context, id string, notifyCustomer bool) (*Return, error)
+
+	Refund(ctx context.Context, input model.ReturnRefundInput) (*model.Refund, error)
+	DisposeReverseFulfillmentOrder(ctx context.Context, dispositions []model.ReverseFulfillmentOrderDisposeInput) ([]model.ReverseFulfillmentOrderLineItem, error)
+}
+
+type ReturnServiceOp struct {
+	client *Client
+}
+
+var _ ReturnService = &ReturnServiceOp{}
+
+// Return is a return along with its line items, which model.Return can't decode as they're of an interface type.
+type Return struct {
+	model.Return
+	ReturnLineItems *Connection[model.ReturnLineItem] `json:"returnLineItems,omitempty"`
+}
+
+// returnPayload is the payload of the mutations changing a return.
+type returnPayload struct {
+	Return     *Return                 `json:"return,omitempty"`
+	UserErrors []model.ReturnUserError `json:"userErrors,omitempty"`
+}
+
+const returnQuery = `
+	id
+	name
+	status
+	totalQuantity
+	order{
+		id
+		legacyResourceId
+		name
+	}
+	decline{
+		reason
+		note
+	}
+	returnLineItems(first:50){
+		edges{
+			node{
+				... on ReturnLineItem{
+					id
+					quantity
+					refundableQuantity
+					refundedQuantity
+					returnReason
+					returnReasonNote
+					customerNote
+					fulfillmentLineItem{
+						id
+						lineItem{
+							id
+							sku
+							title
+						}
+					}
+				}
+			}
+		}
+	}
+	exchangeLineItems(first:50){
+		edges{
+			node{
+				id
+				lineItem{
+					id
+					sku
+					title
+					quantity
+					variant{
+						id
+					}
+				}
+			}
+		}
+	}
+	reverseFulfillmentOrders(first:10){
+		edges{
+			node{
+				id
+				status
+				lineItems(first:50){
+					edges{
+						node{
+							id
+							totalQuantity
+							fulfillmentLineItem{
+								id
+							}
+							dispositions{
+								id
+								type
+								quantity
+								location{
+									id
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+`
+
+func (s *ReturnServiceOp) Get(ctx context.Context, id string) (*Return, error) {
+	q := fmt.Sprintf(`
+		query return($id: ID!) {
+			return(id: $id){
+				%s
+			}
+		}
+	`, returnQuery)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	out := struct {
+		Return *Return `json:"return"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	if out.Return == nil {
+		return nil, fmt.Errorf("return %s: %w", id, ErrNotFound)
+	}
+
+	return out.Return, nil
+}
+
+// Request requests a return on behalf of the customer, to be approved or declined.
+func (s *ReturnServiceOp) Request(ctx context.Context, input model.ReturnRequestInput) (*Return, error) {
+	return s.mutate(ctx, "returnRequest", "$input: ReturnRequestInput!", "input: $input", map[string]interface{}{
+		"input": input,
+	})
+}
+
+// Approve approves the requested return, creating its reverse fulfillment orders.
+func (s *ReturnServiceOp) Approve(ctx context.Context, input model.ReturnApproveRequestInput) (*Return, error) {
+	return s.mutate(ctx, "returnApproveRequest", "$input: ReturnApproveRequestInput!", "input: $input", map[string]interface{}{
+		"input": input,
+	})
+}
+
+func (s *ReturnServiceOp) Decline(ctx context.Context, input model.ReturnDeclineRequestInput) (*Return, error) {
+	return s.mutate(ctx, "returnDeclineRequest", "$input: ReturnDeclineRequestInput!", "input: $input", map[string]interface{}{
+		"input": input,
+	})
+}
+
+// Create creates an approved return, with the exchange line items, if any.
+func (s *ReturnServiceOp) Create(ctx context.Context, input model.ReturnInput) (*Return, error) {
+	return s.mutate(ctx, "returnCreate", "$input: ReturnInput!", "returnInput: $input", map[string]interface{}{
+		"input": input,
+	})
+}
+
+func (s *ReturnServiceOp) Close(ctx context.Context, id string) (*Return, error) {
+	return s.mutate(ctx, "returnClose", "$id: ID!", "id: $id", map[string]interface{}{
+		"id": id,
+	})
+}
+
+func (s *ReturnServiceOp) Reopen(ctx context.Context, id string) (*Return, error) {
+	return s.mutate(ctx, "returnReopen", "$id: ID!", "id: $id", map[string]interface{}{
+		"id": id,
+	})
+}
+
+func (s *ReturnServiceOp) Cancel(ctx context.Context, id string, notifyCustomer bool) (*Return, error) {
+	return s.mutate(ctx, "returnCancel", "$id: ID!, $notifyCustomer: Boolean", "id: $id, notifyCustomer: $notifyCustomer", map[string]interface{}{
+		"id":             id,
+		"notifyCustomer": notifyCustomer,
+	})
+}
+
+// Refund refunds the returned line items.
+func (s *ReturnServiceOp) Refund(ctx context.Context, input model.ReturnRefundInput) (*model.Refund, error) {
+	m := fmt.Sprintf(`
+		mutation returnRefund($input: ReturnRefundInput!) {
+			returnRefund(returnRefundInput: $input){
+				refund{
+					%s
+				}
+				userErrors{
+					field
+					message
+					code
+				}
+			}
+		}
+	`, refundQuery)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	out := struct {
+		ReturnRefundResult model.ReturnRefundPayload `json:"returnRefund"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.ReturnRefundResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return out.ReturnRefundResult.Refund, nil
+}
+
+// DisposeReverseFulfillmentOrder records what happened to the returned items, e.g. whether they were restocked at a location.
+func (s *ReturnServiceOp) DisposeReverseFulfillmentOrder(ctx context.Context, dispositions []model.ReverseFulfillmentOrderDisposeInput) ([]model.ReverseFulfillmentOrderLineItem, error) {
+	m := `
+		mutation reverseFulfillmentOrderDispose($dispositionInputs: [ReverseFulfillmentOrderDisposeInput!]!) {
+			reverseFulfillmentOrderDispose(dispositionInputs: $dispositionInputs){
+				reverseFulfillmentOrderLineItems{
+					id
+					totalQuantity
+					dispositions{
+						id
+						type
+						quantity
+						location{
+							id
+						}
+					}
+				}
+				userErrors{
+					field
+					message
+					code
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"dispositionInputs": dispositions,
+	}
+
+	out := struct {
+		ReverseFulfillmentOrderDisposeResult model.ReverseFulfillmentOrderDisposePayload `json:"reverseFulfillmentOrderDispose"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(out.ReverseFulfillmentOrderDisposeResult.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return out.ReverseFulfillmentOrderDisposeResult.ReverseFulfillmentOrderLineItems, nil
+}
+
+// mutate runs the mutation changing a return and returns the changed return.
+func (s *ReturnServiceOp) mutate(ctx context.Context, name string, definitions string, arguments string, vars map[string]interface{}) (*Return, error) {
+	m := fmt.Sprintf(`
+		mutation %[1]s(%[2]s) {
+			%[1]s(%[3]s){
+				return{
+					%[4]s
+				}
+				userErrors{
+					field
+					message
+					code
+				}
+			}
+		}
+	`, name, definitions, arguments, returnQuery)
+
+	out := map[string]returnPayload{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("mutation: %w", err)
+	}
+
+	res := out[name]
+	if err := newUserErrorsError(res.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return res.Return, nil
+}
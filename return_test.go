@@ -0,0 +1,176 @@
+package shopify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReturnRequest(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"returnRequest":{"return":{"id":"gid://shopify/Return/1","status":"REQUESTED","returnLineItems":{"edges":[{"node":{"id":"gid://shopify/ReturnLineItem/1","quantity":1,"returnReason":"DEFECTIVE"}}]}}}}`,
+		`{"returnApproveRequest":{"userErrors":[{"field":["input","id"],"message":"Return is not requested","code":"INVALID_STATE"}]}}`,
+	)
+	c := srv.client(t)
+
+	ret, err := c.Return.Request(context.Background(), model.ReturnRequestInput{
+		OrderID: "gid://shopify/Order/1",
+		ReturnLineItems: []model.ReturnRequestLineItemInput{
+			{FulfillmentLineItemID: "gid://shopify/FulfillmentLineItem/1", Quantity: 1, ReturnReason: model.ReturnReasonDefective},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.ReturnStatusRequested, ret.Status)
+	require.Len(t, ret.ReturnLineItems.Edges, 1)
+	assert.Equal(t, model.ReturnReasonDefective, ret.ReturnLineItems.Edges[0].Node.ReturnReason)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation returnRequest($input: ReturnRequestInput!)")
+	assert.Contains(t, req.Query, "returnRequest(input: $input)")
+	assert.Equal(t, map[string]interface{}{
+		"orderId": "gid://shopify/Order/1",
+		"returnLineItems": []interface{}{
+			map[string]interface{}{"fulfillmentLineItemId": "gid://shopify/FulfillmentLineItem/1", "quantity": float64(1), "returnReason": "DEFECTIVE"},
+		},
+	}, req.Variables["input"])
+
+	_, err = c.Return.Approve(context.Background(), model.ReturnApproveRequestInput{ID: ret.ID})
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, "INVALID_STATE", userErrs.Errors[0].Code)
+
+	req = srv.last(t)
+	assert.Contains(t, req.Query, "mutation returnApproveRequest($input: ReturnApproveRequestInput!)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/Return/1"}, req.Variables["input"])
+}
+
+func TestReturnCreateWithExchange(t *testing.T) {
+	srv := newGraphQLServer(t, `{"returnCreate":{"return":{"id":"gid://shopify/Return/1","status":"OPEN",
+		"exchangeLineItems":{"edges":[{"node":{"id":"gid://shopify/ExchangeLineItem/1","lineItem":{"id":"gid://shopify/LineItem/3","quantity":1,"variant":{"id":"gid://shopify/ProductVariant/2"}}}}]}
+	}}}`)
+	c := srv.client(t)
+
+	ret, err := c.Return.Create(context.Background(), model.ReturnInput{
+		OrderID: "gid://shopify/Order/1",
+		ReturnLineItems: []model.ReturnLineItemInput{
+			{FulfillmentLineItemID: "gid://shopify/FulfillmentLineItem/1", Quantity: 1, ReturnReason: model.ReturnReasonSizeTooSmall},
+		},
+		ExchangeLineItems: []model.ExchangeLineItemInput{
+			{VariantID: ptr("gid://shopify/ProductVariant/2"), Quantity: 1},
+		},
+		NotifyCustomer: ptr(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.ReturnStatusOpen, ret.Status)
+	require.Len(t, ret.ExchangeLineItems.Edges, 1)
+	assert.Equal(t, "gid://shopify/ProductVariant/2", ret.ExchangeLineItems.Edges[0].Node.LineItem.Variant.ID)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation returnCreate($input: ReturnInput!)")
+	assert.Contains(t, req.Query, "returnCreate(returnInput: $input)")
+	assert.Equal(t, map[string]interface{}{
+		"orderId": "gid://shopify/Order/1",
+		"returnLineItems": []interface{}{
+			map[string]interface{}{"fulfillmentLineItemId": "gid://shopify/FulfillmentLineItem/1", "quantity": float64(1), "returnReason": "SIZE_TOO_SMALL"},
+		},
+		"exchangeLineItems": []interface{}{
+			map[string]interface{}{"variantId": "gid://shopify/ProductVariant/2", "quantity": float64(1)},
+		},
+		"notifyCustomer": true,
+	}, req.Variables["input"])
+}
+
+func TestReturnStatusChanges(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"returnDeclineRequest":{"return":{"id":"gid://shopify/Return/1","status":"DECLINED","decline":{"reason":"FINAL_SALE","note":"Final sale"}}}}`,
+		`{"returnClose":{"return":{"id":"gid://shopify/Return/1","status":"CLOSED"}}}`,
+		`{"returnReopen":{"return":{"id":"gid://shopify/Return/1","status":"OPEN"}}}`,
+		`{"returnCancel":{"return":{"id":"gid://shopify/Return/1","status":"CANCELED"}}}`,
+	)
+	c := srv.client(t)
+	ctx := context.Background()
+
+	ret, err := c.Return.Decline(ctx, model.ReturnDeclineRequestInput{ID: "gid://shopify/Return/1", DeclineReason: model.ReturnDeclineReasonFinalSale, DeclineNote: ptr("Final sale")})
+	require.NoError(t, err)
+	assert.Equal(t, model.ReturnStatusDeclined, ret.Status)
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "returnDeclineRequest(input: $input)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/Return/1", "declineReason": "FINAL_SALE", "declineNote": "Final sale"}, req.Variables["input"])
+
+	ret, err = c.Return.Close(ctx, "gid://shopify/Return/1")
+	require.NoError(t, err)
+	assert.Equal(t, model.ReturnStatusClosed, ret.Status)
+	req = srv.last(t)
+	assert.Contains(t, req.Query, "mutation returnClose($id: ID!)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/Return/1"}, req.Variables)
+
+	ret, err = c.Return.Reopen(ctx, "gid://shopify/Return/1")
+	require.NoError(t, err)
+	assert.Equal(t, model.ReturnStatusOpen, ret.Status)
+	assert.Contains(t, srv.last(t).Query, "returnReopen(id: $id)")
+
+	ret, err = c.Return.Cancel(ctx, "gid://shopify/Return/1", true)
+	require.NoError(t, err)
+	assert.Equal(t, model.ReturnStatusCanceled, ret.Status)
+	req = srv.last(t)
+	assert.Contains(t, req.Query, "mutation returnCancel($id: ID!, $notifyCustomer: Boolean)")
+	assert.Equal(t, map[string]interface{}{"id": "gid://shopify/Return/1", "notifyCustomer": true}, req.Variables)
+}
+
+func TestReturnRefund(t *testing.T) {
+	srv := newGraphQLServer(t, `{"returnRefund":{"refund":{"id":"gid://shopify/Refund/1"}}}`)
+	c := srv.client(t)
+
+	refund, err := c.Return.Refund(context.Background(), model.ReturnRefundInput{
+		ReturnID:              "gid://shopify/Return/1",
+		ReturnRefundLineItems: []model.ReturnRefundLineItemInput{{ReturnLineItemID: "gid://shopify/ReturnLineItem/1", Quantity: 1}},
+		NotifyCustomer:        ptr(false),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "gid://shopify/Refund/1", refund.ID)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation returnRefund($input: ReturnRefundInput!)")
+	assert.Contains(t, req.Query, "returnRefund(returnRefundInput: $input)")
+	assert.Equal(t, map[string]interface{}{
+		"returnId":              "gid://shopify/Return/1",
+		"returnRefundLineItems": []interface{}{map[string]interface{}{"returnLineItemId": "gid://shopify/ReturnLineItem/1", "quantity": float64(1)}},
+		"notifyCustomer":        false,
+	}, req.Variables["input"])
+}
+
+func TestReturnDisposeReverseFulfillmentOrder(t *testing.T) {
+	srv := newGraphQLServer(t,
+		`{"reverseFulfillmentOrderDispose":{"reverseFulfillmentOrderLineItems":[{"id":"gid://shopify/ReverseFulfillmentOrderLineItem/1","totalQuantity":2,"dispositions":[{"id":"gid://shopify/ReverseFulfillmentOrderDisposition/1","type":"RESTOCKED","quantity":1,"location":{"id":"gid://shopify/Location/1"}},{"id":"gid://shopify/ReverseFulfillmentOrderDisposition/2","type":"MISSING","quantity":1}]}]}}`,
+		`{"reverseFulfillmentOrderDispose":{"userErrors":[{"field":["dispositionInputs","0","quantity"],"message":"Quantity is invalid","code":"INVALID"}]}}`,
+	)
+	c := srv.client(t)
+
+	dispositions := []model.ReverseFulfillmentOrderDisposeInput{
+		{ReverseFulfillmentOrderLineItemID: "gid://shopify/ReverseFulfillmentOrderLineItem/1", Quantity: 1, LocationID: ptr("gid://shopify/Location/1"), DispositionType: model.ReverseFulfillmentOrderDispositionTypeRestocked},
+		{ReverseFulfillmentOrderLineItemID: "gid://shopify/ReverseFulfillmentOrderLineItem/1", Quantity: 1, DispositionType: model.ReverseFulfillmentOrderDispositionTypeMissing},
+	}
+	lineItems, err := c.Return.DisposeReverseFulfillmentOrder(context.Background(), dispositions)
+	require.NoError(t, err)
+	require.Len(t, lineItems, 1)
+	require.Len(t, lineItems[0].Dispositions, 2)
+	assert.Equal(t, model.ReverseFulfillmentOrderDispositionTypeRestocked, lineItems[0].Dispositions[0].Type)
+	assert.Equal(t, "gid://shopify/Location/1", lineItems[0].Dispositions[0].Location.ID)
+
+	req := srv.last(t)
+	assert.Contains(t, req.Query, "mutation reverseFulfillmentOrderDispose($dispositionInputs: [ReverseFulfillmentOrderDisposeInput!]!)")
+	assert.Equal(t, map[string]interface{}{
+		"dispositionInputs": []interface{}{
+			map[string]interface{}{"reverseFulfillmentOrderLineItemId": "gid://shopify/ReverseFulfillmentOrderLineItem/1", "quantity": float64(1), "locationId": "gid://shopify/Location/1", "dispositionType": "RESTOCKED"},
+			map[string]interface{}{"reverseFulfillmentOrderLineItemId": "gid://shopify/ReverseFulfillmentOrderLineItem/1", "quantity": float64(1), "dispositionType": "MISSING"},
+		},
+	}, req.Variables)
+
+	_, err = c.Return.DisposeReverseFulfillmentOrder(context.Background(), dispositions[:1])
+	var userErrs *UserErrorsError
+	require.ErrorAs(t, err, &userErrs)
+	assert.Equal(t, "INVALID", userErrs.Errors[0].Code)
+}
@@ -0,0 +1,202 @@
+package shopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SearchQuery builds the `query` argument of the connections in the Shopify search syntax, escaping the terms.
+// The terms are joined with AND unless separated with Or:
+//
+//	shopify.Search().Field("status", "active").And().Range("created_at", from, to).String()
+//	// status:active AND (created_at:>='2024-01-01T00:00:00Z' AND created_at:<='2024-02-01T00:00:00Z')
+type SearchQuery struct {
+	parts []string
+	// op is the connective before the next term, or empty for the default AND.
+	op string
+	// not negates the next term.
+	not bool
+}
+
+// Search starts building a search query.
+func Search() *SearchQuery {
+	return &SearchQuery{}
+}
+
+// Term adds a free text term matched against the default fields of the resource.
+func (q *SearchQuery) Term(value string) *SearchQuery {
+	return q.add(quoteSearchValue(value))
+}
+
+// Field adds a `field:value` term.
+func (q *SearchQuery) Field(field string, value any) *SearchQuery {
+	return q.add(escapeSearchTerm(field) + ":" + formatSearchValue(value))
+}
+
+// Prefix adds a `field:value*` term matching the values starting with the prefix.
+func (q *SearchQuery) Prefix(field string, prefix string) *SearchQuery {
+	return q.add(escapeSearchTerm(field) + ":" + escapeSearchTerm(prefix) + "*")
+}
+
+// SearchComparator is the comparison operator of a range search term.
+type SearchComparator string
+
+const (
+	SearchLess           SearchComparator = "<"
+	SearchLessOrEqual    SearchComparator = "<="
+	SearchGreater        SearchComparator = ">"
+	SearchGreaterOrEqual SearchComparator = ">="
+)
+
+// Compare adds a `field:<op>value` term.
+func (q *SearchQuery) Compare(field string, op SearchComparator, value any) *SearchQuery {
+	return q.add(escapeSearchTerm(field) + ":" + string(op) + formatSearchValue(value))
+}
+
+// Range adds the terms matching the field values between from and to, inclusive. A nil or zero bound is omitted.
+func (q *SearchQuery) Range(field string, from, to any) *SearchQuery {
+	lower, upper := !isZeroSearchValue(from), !isZeroSearchValue(to)
+	switch {
+	case lower && upper:
+		return q.Group(Search().Compare(field, SearchGreaterOrEqual, from).Compare(field, SearchLessOrEqual, to))
+	case lower:
+		return q.Compare(field, SearchGreaterOrEqual, from)
+	case upper:
+		return q.Compare(field, SearchLessOrEqual, to)
+	}
+	return q
+}
+
+// Exists adds a `field:*` term matching the resources having a value for the field.
+func (q *SearchQuery) Exists(field string) *SearchQuery {
+	return q.add(escapeSearchTerm(field) + ":*")
+}
+
+// Group adds the sub-query in parentheses.
+func (q *SearchQuery) Group(sub *SearchQuery) *SearchQuery {
+	if sub == nil || len(sub.parts) == 0 {
+		return q
+	}
+	return q.add("(" + sub.String() + ")")
+}
+
+// And joins the previous and the next terms with AND, which is also the default.
+func (q *SearchQuery) And() *SearchQuery {
+	q.op = "AND"
+	return q
+}
+
+// Or joins the previous and the next terms with OR.
+func (q *SearchQuery) Or() *SearchQuery {
+	q.op = "OR"
+	return q
+}
+
+// Not negates the next term.
+func (q *SearchQuery) Not() *SearchQuery {
+	q.not = true
+	return q
+}
+
+// String returns the query in the Shopify search syntax.
+func (q *SearchQuery) String() string {
+	if q == nil {
+		return ""
+	}
+	return strings.Join(q.parts, " ")
+}
+
+func (q *SearchQuery) add(term string) *SearchQuery {
+	if len(q.parts) > 0 {
+		op := q.op
+		if op == "" {
+			op = "AND"
+		}
+		q.parts = append(q.parts, op)
+	}
+	if q.not {
+		term = "NOT " + term
+	}
+	q.parts = append(q.parts, term)
+	q.op, q.not = "", false
+
+	return q
+}
+
+func isZeroSearchValue(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case time.Time:
+		return v.IsZero()
+	case *time.Time:
+		return v == nil || v.IsZero()
+	}
+	return false
+}
+
+func formatSearchValue(v any) string {
+	switch v := v.(type) {
+	case time.Time:
+		return "'" + v.UTC().Format(time.RFC3339) + "'"
+	case *time.Time:
+		if v == nil {
+			return quoteSearchValue("")
+		}
+		return formatSearchValue(*v)
+	case string:
+		return quoteSearchValue(v)
+	case fmt.Stringer:
+		return quoteSearchValue(v.String())
+	}
+	return quoteSearchValue(fmt.Sprint(v))
+}
+
+// quoteSearchValue returns the value as is if it has no special characters, or as a quoted phrase otherwise.
+func quoteSearchValue(v string) string {
+	if v != "" && !strings.ContainsAny(v, searchSpecialChars+" \t\r\n") && !isSearchKeyword(v) {
+		return v
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range v {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+const searchSpecialChars = `\:()"'*<>=-`
+
+// escapeSearchTerm escapes the special characters of an unquoted term with backslashes.
+func escapeSearchTerm(v string) string {
+	var b strings.Builder
+	for _, r := range v {
+		if strings.ContainsRune(searchSpecialChars, r) || r == ' ' || r == '\t' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func isSearchKeyword(v string) bool {
+	switch v {
+	case "AND", "OR", "NOT":
+		return true
+	}
+	return false
+}
+
+// graphQLString returns the string as a GraphQL string literal, to be embedded in the query documents,
+// e.g. the bulk queries, that can't use variables.
+func graphQLString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
@@ -0,0 +1,59 @@
+package shopify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		q    *SearchQuery
+		want string
+	}{
+		{
+			name: "fields and range",
+			q:    Search().Field("status", "active").And().Range("created_at", from, to),
+			want: `status:active AND (created_at:>='2024-01-01T00:00:00Z' AND created_at:<='2024-02-01T00:00:00Z')`,
+		},
+		{
+			name: "open range",
+			q:    Search().Range("updated_at", from, nil),
+			want: `updated_at:>='2024-01-01T00:00:00Z'`,
+		},
+		{
+			name: "or and not",
+			q:    Search().Field("tag", "sale").Or().Not().Field("vendor", "ACME"),
+			want: `tag:sale OR NOT vendor:ACME`,
+		},
+		{
+			name: "quoted values",
+			q:    Search().Field("title", `5" "pipe" \ OR:1`).Term("AND"),
+			want: `title:"5\" \"pipe\" \\ OR:1" AND "AND"`,
+		},
+		{
+			name: "escaped fields and prefixes",
+			q:    Search().Prefix("sku", "AB-1 ").Exists("metafields.custom.a:b"),
+			want: `sku:AB\-1\ * AND metafields.custom.a\:b:*`,
+		},
+		{
+			name: "numbers",
+			q:    Search().Compare("inventory_total", SearchGreater, 5).Group(Search()),
+			want: `inventory_total:>5`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, c.q.String())
+		})
+	}
+}
+
+func TestGraphQLString(t *testing.T) {
+	assert.Equal(t, `"title:\"a\\\"b\" \n"`, graphQLString(`title:"a\"b" `+"\n"))
+}
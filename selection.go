@@ -0,0 +1,168 @@
+package shopify
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// nestedConnectionPageSize is the number of nodes queried from the nested connections which aren't paginated.
+const nestedConnectionPageSize = 50
+
+var fragmentNameRegex = regexp.MustCompile(`^\s*fragment\s+(\w+)\s+on\s+\w+`)
+
+// FieldSelector selects the fields of the resources queried by the service methods, replacing their default fields.
+// It's either a Selection or a Fragment. The selectors passed together are merged.
+type FieldSelector interface {
+	selectionSet(paged string, bulk bool) (selectionSet, error)
+}
+
+// selectionSet is the rendered selection of the fields along with the fragments it spreads.
+type selectionSet struct {
+	fields    string
+	fragments string
+	// paged reports whether the connection paginated by the method is selected, with the `$first` and `$after` arguments.
+	paged bool
+}
+
+// pageVariables returns the variable definitions of the paged connection arguments.
+func (s selectionSet) pageVariables() string {
+	if !s.paged {
+		return ""
+	}
+	return ", $first: Int, $after: String"
+}
+
+// Selection is a projection of the resource fields, e.g.
+//
+//	shopify.Select("title", "status", "publishedAt").
+//		Object("seo", shopify.Select("title", "description")).
+//		Connection("variants", shopify.Select("sku", "price"))
+//
+// The `id` of the resources is always selected in bulk operations, so that their nested connections can be parsed.
+type Selection struct {
+	fields []selectedField
+}
+
+type selectedField struct {
+	name       string
+	sub        *Selection
+	connection bool
+}
+
+var _ FieldSelector = &Selection{}
+
+// Select starts a selection of the scalar fields.
+func Select(fields ...string) *Selection {
+	return (&Selection{}).Fields(fields...)
+}
+
+// Fields adds the scalar fields.
+func (s *Selection) Fields(fields ...string) *Selection {
+	for _, f := range fields {
+		s.fields = append(s.fields, selectedField{name: f})
+	}
+	return s
+}
+
+// Object adds the object field along with the selection of its fields.
+func (s *Selection) Object(name string, sub *Selection) *Selection {
+	s.fields = append(s.fields, selectedField{name: name, sub: sub})
+	return s
+}
+
+// Connection adds the connection field along with the selection of its node fields.
+// The connection is queried entirely in bulk operations and paginated by the methods that paginate it,
+// e.g. the product variants of ProductService.Get, otherwise only its first 50 nodes are queried.
+func (s *Selection) Connection(name string, nodes *Selection) *Selection {
+	s.fields = append(s.fields, selectedField{name: name, sub: nodes, connection: true})
+	return s
+}
+
+func (s *Selection) selectionSet(paged string, bulk bool) (selectionSet, error) {
+	var b strings.Builder
+	if bulk {
+		b.WriteString("id\n")
+	}
+	isPaged := s.write(&b, paged, bulk)
+
+	return selectionSet{fields: b.String(), paged: isPaged}, nil
+}
+
+// write writes the selection of the fields and reports whether the paged connection is among them.
+func (s *Selection) write(b *strings.Builder, paged string, bulk bool) bool {
+	isPaged := false
+	for _, f := range s.fields {
+		b.WriteString(f.name)
+		switch {
+		case f.connection:
+			nodes := f.sub
+			if nodes == nil {
+				nodes = Select("id")
+			}
+			switch {
+			case bulk:
+				b.WriteString("{\nedges{\nnode{\nid\n")
+				nodes.write(b, "", bulk)
+				b.WriteString("}\n}\n}")
+			case f.name == paged:
+				isPaged = true
+				b.WriteString("(first: $first, after: $after){\nedges{\nnode{\n")
+				nodes.write(b, "", bulk)
+				b.WriteString("}\ncursor\n}\npageInfo{\nhasNextPage\nendCursor\n}\n}")
+			default:
+				fmt.Fprintf(b, "(first: %d){\nedges{\nnode{\n", nestedConnectionPageSize)
+				nodes.write(b, "", bulk)
+				b.WriteString("}\n}\n}")
+			}
+		case f.sub != nil:
+			b.WriteString("{\n")
+			f.sub.write(b, "", bulk)
+			b.WriteString("}")
+		}
+		b.WriteString("\n")
+	}
+	return isPaged
+}
+
+// Fragment is a GraphQL fragment definition on the resource type, e.g.
+//
+//	shopify.Fragment(`fragment product on Product { title status media(first: 10) { nodes { alt } } }`)
+//
+// The fragment fields are queried as they are, so its connections must be selected with the pagination arguments
+// in regular queries and without them in bulk operations. The connections of a fragment aren't paginated.
+type Fragment string
+
+var _ FieldSelector = Fragment("")
+
+func (f Fragment) selectionSet(paged string, bulk bool) (selectionSet, error) {
+	m := fragmentNameRegex.FindStringSubmatch(string(f))
+	if len(m) != 2 {
+		return selectionSet{}, fmt.Errorf("invalid fragment: %q", f)
+	}
+
+	fields := "..." + m[1] + "\n"
+	if bulk {
+		fields = "id\n" + fields
+	}
+	return selectionSet{fields: fields, fragments: string(f)}, nil
+}
+
+// selectFields merges the selectors, or returns the default selection if there are none.
+func selectFields(fields []FieldSelector, paged string, bulk bool, def selectionSet) (selectionSet, error) {
+	if len(fields) == 0 {
+		return def, nil
+	}
+
+	var out selectionSet
+	for _, f := range fields {
+		sel, err := f.selectionSet(paged, bulk)
+		if err != nil {
+			return selectionSet{}, err
+		}
+		out.fields += sel.fields
+		out.fragments += sel.fragments + "\n"
+		out.paged = out.paged || sel.paged
+	}
+	return out, nil
+}
@@ -0,0 +1,54 @@
+package shopify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compactSelection(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func TestSelection(t *testing.T) {
+	sel := Select("title", "status").
+		Object("seo", Select("title")).
+		Connection("variants", Select("sku"))
+
+	bulk, err := selectFields([]FieldSelector{sel}, "", true, selectionSet{})
+	require.NoError(t, err)
+	assert.Equal(t, "id title status seo{ title } variants{ edges{ node{ id sku } } }", compactSelection(bulk.fields))
+	assert.False(t, bulk.paged)
+
+	paged, err := selectFields([]FieldSelector{sel}, "variants", false, selectionSet{})
+	require.NoError(t, err)
+	assert.Equal(t, "title status seo{ title } variants(first: $first, after: $after){ edges{ node{ sku } cursor } pageInfo{ hasNextPage endCursor } }", compactSelection(paged.fields))
+	assert.True(t, paged.paged)
+	assert.Equal(t, ", $first: Int, $after: String", paged.pageVariables())
+
+	nested, err := selectFields([]FieldSelector{sel}, "", false, selectionSet{})
+	require.NoError(t, err)
+	assert.Contains(t, nested.fields, "variants(first: 50){")
+	assert.False(t, nested.paged)
+}
+
+func TestSelectionFragment(t *testing.T) {
+	f := Fragment(`fragment productFields on Product { title media(first: 5) { nodes { alt } } }`)
+
+	sel, err := selectFields([]FieldSelector{f, Select("vendor")}, "", true, selectionSet{})
+	require.NoError(t, err)
+	assert.Equal(t, "id ...productFields id vendor", compactSelection(sel.fields))
+	assert.Contains(t, sel.fragments, string(f))
+
+	_, err = selectFields([]FieldSelector{Fragment("{ title }")}, "", false, selectionSet{})
+	assert.Error(t, err)
+}
+
+func TestSelectionDefault(t *testing.T) {
+	def := selectionSet{fields: productQuery, paged: true}
+	sel, err := selectFields(nil, "variants", false, def)
+	require.NoError(t, err)
+	assert.Equal(t, def, sel)
+}
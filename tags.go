@@ -0,0 +1,70 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	"github.com/r0busta/graphql"
+)
+
+type mutationTagsAdd struct {
+	TagsAddResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"tagsAdd(id: $id, tags: $tags)" json:"tagsAdd"`
+}
+
+type mutationTagsRemove struct {
+	TagsRemoveResult struct {
+		UserErrors []model.UserError `json:"userErrors,omitempty"`
+	} `graphql:"tagsRemove(id: $id, tags: $tags)" json:"tagsRemove"`
+}
+
+// addTags adds the tags to the taggable resource, e.g. an order or a customer.
+func addTags(ctx context.Context, c *Client, id string, tags []string) error {
+	m := mutationTagsAdd{}
+
+	vars := map[string]interface{}{
+		"id":   id,
+		"tags": graphQLStrings(tags),
+	}
+	err := c.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.TagsAddResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// removeTags removes the tags from the taggable resource.
+func removeTags(ctx context.Context, c *Client, id string, tags []string) error {
+	m := mutationTagsRemove{}
+
+	vars := map[string]interface{}{
+		"id":   id,
+		"tags": graphQLStrings(tags),
+	}
+	err := c.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return fmt.Errorf("mutation: %w", err)
+	}
+
+	if err := newUserErrorsError(m.TagsRemoveResult.UserErrors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// graphQLStrings converts the strings to the `[String!]!` variable type, as plain strings are sent as IDs.
+func graphQLStrings(ss []string) []graphql.String {
+	out := make([]graphql.String, 0, len(ss))
+	for _, s := range ss {
+		out = append(out, graphql.String(s))
+	}
+	return out
+}
@@ -0,0 +1,175 @@
+package shopify
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	instrumentationName = "github.com/r0busta/go-shopify-graphql/v9"
+)
+
+// WithTracerProvider enables tracing of every GraphQL operation and bulk operation phase.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *Client) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider enables the latency, query cost and retries metrics of the GraphQL operations.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *Client) {
+		c.meterProvider = mp
+	}
+}
+
+type telemetry struct {
+	tracer trace.Tracer
+
+	duration metric.Float64Histogram
+	cost     metric.Float64Histogram
+	retries  metric.Int64Counter
+}
+
+func newTelemetry(tp trace.TracerProvider, mp metric.MeterProvider) (*telemetry, error) {
+	if tp == nil {
+		tp = tracenoop.NewTracerProvider()
+	}
+	if mp == nil {
+		mp = metricnoop.NewMeterProvider()
+	}
+
+	t := &telemetry{
+		tracer: tp.Tracer(instrumentationName),
+	}
+
+	meter := mp.Meter(instrumentationName)
+
+	var err error
+	t.duration, err = meter.Float64Histogram("shopify.graphql.duration",
+		metric.WithDescription("Duration of the GraphQL operations."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	t.cost, err = meter.Float64Histogram("shopify.graphql.query_cost",
+		metric.WithDescription("Actual calculated query cost of the GraphQL operations."),
+		metric.WithUnit("{point}"))
+	if err != nil {
+		return nil, err
+	}
+
+	t.retries, err = meter.Int64Counter("shopify.graphql.retries",
+		metric.WithDescription("Number of retried GraphQL requests."),
+		metric.WithUnit("{retry}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// startSpan starts a span with the client attributes.
+func (c *Client) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return c.telemetry.tracer.Start(ctx, name, trace.WithAttributes(append(c.attributes(), attrs...)...))
+}
+
+func (c *Client) attributes() []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if c.shopName != "" {
+		attrs = append(attrs, attribute.String("shopify.shop", c.shopName))
+	}
+	if c.apiVersion != "" {
+		attrs = append(attrs, attribute.String("shopify.api_version", c.apiVersion))
+	}
+	return attrs
+}
+
+// tracePhase runs the function within a child span.
+func (c *Client) tracePhase(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	ctx, span := c.startSpan(ctx, name)
+	err := fn(ctx)
+	endSpan(span, err)
+	return err
+}
+
+// endSpan records the error, if any, and ends the span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// recordOperation sets the span attributes and records the metrics of a finished GraphQL operation.
+func (c *Client) recordOperation(ctx context.Context, span trace.Span, operation string, start time.Time, info *graphqlclient.ResponseInfo, out interface{}, err error) {
+	attrs := append(c.attributes(), attribute.String("graphql.operation.name", operation))
+	metricAttrs := metric.WithAttributes(attrs...)
+
+	c.telemetry.duration.Record(ctx, time.Since(start).Seconds(), metricAttrs)
+	if info.Retries > 0 {
+		c.telemetry.retries.Add(ctx, int64(info.Retries), metricAttrs)
+	}
+
+	spanAttrs := []attribute.KeyValue{
+		attribute.Int("shopify.retries", info.Retries),
+		attribute.Int("shopify.user_errors.count", countUserErrors(out)),
+	}
+	if info.Cost != nil {
+		spanAttrs = append(spanAttrs,
+			attribute.Float64("shopify.query_cost.requested", info.Cost.RequestedQueryCost),
+			attribute.Float64("shopify.throttle_status.maximum_available", info.Cost.ThrottleStatus.MaximumAvailable),
+			attribute.Float64("shopify.throttle_status.currently_available", info.Cost.ThrottleStatus.CurrentlyAvailable),
+			attribute.Float64("shopify.throttle_status.restore_rate", info.Cost.ThrottleStatus.RestoreRate),
+		)
+		if info.Cost.ActualQueryCost != nil {
+			spanAttrs = append(spanAttrs, attribute.Float64("shopify.query_cost.actual", *info.Cost.ActualQueryCost))
+			c.telemetry.cost.Record(ctx, *info.Cost.ActualQueryCost, metricAttrs)
+		}
+	}
+	span.SetAttributes(spanAttrs...)
+
+	endSpan(span, err)
+}
+
+// countUserErrors counts the user errors of the mutation payloads in the result struct.
+func countUserErrors(out interface{}) int {
+	v := reflect.ValueOf(out)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return 0
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0
+	}
+
+	n := 0
+	for i := 0; i < v.NumField(); i++ {
+		payload := reflect.Indirect(v.Field(i))
+		if payload.Kind() != reflect.Struct {
+			continue
+		}
+		for j := 0; j < payload.NumField(); j++ {
+			f := payload.Type().Field(j)
+			if strings.HasSuffix(f.Name, "UserErrors") && f.Type.Kind() == reflect.Slice {
+				n += payload.Field(j).Len()
+			}
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,157 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/r0busta/go-shopify-graphql-model/v4/graph/model"
+	graphqlclient "github.com/r0busta/go-shopify-graphql/v9/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestTelemetry() (*tracetest.SpanRecorder, *sdktrace.TracerProvider, *sdkmetric.ManualReader, *sdkmetric.MeterProvider) {
+	sr := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	return sr, sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)), reader, sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func findSpans(spans []sdktrace.ReadOnlySpan, name string) []sdktrace.ReadOnlySpan {
+	var res []sdktrace.ReadOnlySpan
+	for _, s := range spans {
+		if s.Name() == name {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func findMetric(t *testing.T, reader *sdkmetric.ManualReader, name string) metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m.Data
+			}
+		}
+	}
+	require.Failf(t, "metric not recorded", "%s", name)
+	return nil
+}
+
+func TestOperationTelemetry(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data":{"location":{"id":"gid://shopify/Location/1","name":"Warehouse"}},
+			"extensions":{"cost":{"requestedQueryCost":2,"actualQueryCost":1,"throttleStatus":{"maximumAvailable":2000,"currentlyAvailable":1999,"restoreRate":100}}}
+		}`))
+	}))
+	defer srv.Close()
+
+	sr, tp, reader, mp := newTestTelemetry()
+	c, err := NewClientFromConfig(Config{StoreName: "store", AccessToken: "token", APIVersion: "2025-04"},
+		WithBaseURL(srv.URL),
+		WithGraphQLClientOptions(graphqlclient.WithRetryPolicy(graphqlclient.RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})),
+		WithTracerProvider(tp),
+		WithMeterProvider(mp),
+		WithLogger(nil),
+	)
+	require.NoError(t, err)
+
+	_, err = c.Location.Get(context.Background(), "gid://shopify/Location/1")
+	require.NoError(t, err)
+
+	spans := sr.Ended()
+	require.Len(t, spans, 1, "one span per operation, however many times it's retried")
+	assert.Equal(t, "shopify.graphql location", spans[0].Name())
+	attrs := spanAttributes(spans[0])
+	assert.Equal(t, "location", attrs["graphql.operation.name"].AsString())
+	assert.Equal(t, "store", attrs["shopify.shop"].AsString())
+	assert.Equal(t, "2025-04", attrs["shopify.api_version"].AsString())
+	assert.Equal(t, 2.0, attrs["shopify.query_cost.requested"].AsFloat64())
+	assert.Equal(t, 1.0, attrs["shopify.query_cost.actual"].AsFloat64())
+	assert.Equal(t, 2000.0, attrs["shopify.throttle_status.maximum_available"].AsFloat64())
+	assert.Equal(t, 1999.0, attrs["shopify.throttle_status.currently_available"].AsFloat64())
+	assert.Equal(t, 100.0, attrs["shopify.throttle_status.restore_rate"].AsFloat64())
+	assert.Equal(t, int64(1), attrs["shopify.retries"].AsInt64())
+	assert.Equal(t, int64(0), attrs["shopify.user_errors.count"].AsInt64())
+
+	duration := findMetric(t, reader, "shopify.graphql.duration").(metricdata.Histogram[float64])
+	require.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+	assert.Greater(t, duration.DataPoints[0].Sum, 0.0)
+	opName, _ := duration.DataPoints[0].Attributes.Value("graphql.operation.name")
+	assert.Equal(t, "location", opName.AsString())
+
+	cost := findMetric(t, reader, "shopify.graphql.query_cost").(metricdata.Histogram[float64])
+	require.Len(t, cost.DataPoints, 1)
+	assert.Equal(t, 1.0, cost.DataPoints[0].Sum)
+
+	retries := findMetric(t, reader, "shopify.graphql.retries").(metricdata.Sum[int64])
+	require.Len(t, retries.DataPoints, 1)
+	assert.Equal(t, int64(1), retries.DataPoints[0].Value)
+}
+
+func TestOperationTelemetryUserErrors(t *testing.T) {
+	gql := &fakeGraphQL{responses: []string{`{"customerMerge":{"userErrors":[{"field":["customerTwoId"],"message":"Customer has gift cards","code":"CUSTOMER_HAS_GIFT_CARDS"}]}}`}}
+	sr, tp, _, mp := newTestTelemetry()
+	c, err := NewClientE(WithGraphQLClient(gql), WithTracerProvider(tp), WithMeterProvider(mp), WithLogger(nil))
+	require.NoError(t, err)
+
+	_, err = c.Customer.Merge(context.Background(), "gid://shopify/Customer/1", "gid://shopify/Customer/2", nil)
+	require.Error(t, err)
+
+	spans := findSpans(sr.Ended(), "shopify.graphql customerMerge")
+	require.Len(t, spans, 1)
+	assert.Equal(t, int64(1), spanAttributes(spans[0])["shopify.user_errors.count"].AsInt64())
+}
+
+func TestBulkQueryTelemetry(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testBulkResult))
+	}))
+	defer srv.Close()
+
+	sr, tp, _, mp := newTestTelemetry()
+	c, err := NewClientE(WithGraphQLClient(&fakeBulkGraphQL{url: srv.URL}), WithTracerProvider(tp), WithMeterProvider(mp), WithLogger(nil))
+	require.NoError(t, err)
+
+	res := []model.Product{}
+	err = c.BulkOperation.BulkQuery(context.Background(), "{ products { edges { node { id } } } }", &res)
+	require.NoError(t, err)
+
+	spans := sr.Ended()
+	root := findSpans(spans, "shopify.bulk_query")
+	require.Len(t, root, 1)
+	assert.Equal(t, "gid://shopify/BulkOperation/1", spanAttributes(root[0])["shopify.bulk_operation.id"].AsString())
+
+	for _, phase := range []string{"post", "poll", "download", "parse"} {
+		s := findSpans(spans, "shopify.bulk_query."+phase)
+		require.Len(t, s, 1, phase)
+		assert.Equal(t, root[0].SpanContext().SpanID(), s[0].Parent().SpanID(), phase)
+	}
+}
@@ -0,0 +1,385 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math/rand/v2"
+	"mime"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultDownloadMaxRetries = 5
+	defaultDownloadMinBackoff = 1 * time.Second
+	defaultDownloadMaxBackoff = 30 * time.Second
+)
+
+var (
+	ErrDownloadTooLarge      = errors.New("download exceeds the size limit")
+	ErrDownloadCorrupted     = errors.New("download checksum mismatch")
+	ErrDownloadNotResumable  = errors.New("download can't be resumed")
+	ErrUnexpectedContentType = errors.New("unexpected content type")
+)
+
+// Downloader downloads large files, retrying the transient failures and resuming the interrupted transfers
+// with HTTP range requests. Gzip-compressed files are decompressed. The zero value is ready to use.
+type Downloader struct {
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// MaxRetries is the maximum number of consecutive retries without any progress. Defaults to 5.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry, doubled on every subsequent retry. Defaults to 1s.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between retries. Defaults to 30s.
+	MaxBackoff time.Duration
+	// MaxSize limits the size of the downloaded (decompressed) content. Zero means no limit.
+	MaxSize int64
+}
+
+// Open starts downloading the file and returns its content. The content is read from the network as it's consumed.
+func (d *Downloader) Open(ctx context.Context, url string) (io.ReadCloser, error) {
+	r := &resumableReader{
+		ctx:  ctx,
+		d:    d,
+		url:  url,
+		size: -1,
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+
+	if d.MaxSize > 0 && !r.gzipEncoded && r.size > d.MaxSize {
+		r.Close()
+		return nil, fmt.Errorf("%w: %d bytes", ErrDownloadTooLarge, r.size)
+	}
+
+	br := bufio.NewReader(r)
+	var content io.Reader = br
+	var gz *gzip.Reader
+	if magic, _ := br.Peek(2); r.gzipEncoded || bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		var err error
+		gz, err = gzip.NewReader(br)
+		if err != nil {
+			r.Close()
+			return nil, fmt.Errorf("gzip: %w", err)
+		}
+	}
+	if gz != nil {
+		content = gz
+	}
+	if d.MaxSize > 0 {
+		content = &limitedReader{r: content, n: d.MaxSize}
+	}
+
+	return &downloadReader{Reader: content, raw: r, gz: gz}, nil
+}
+
+// DownloadFile downloads the file into the file path.
+func (d *Downloader) DownloadFile(ctx context.Context, filepath string, url string) error {
+	r, err := d.Open(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	out, err := os.Create(filepath)
+	if err != nil {
+		return err
+	}
+	defer CloseFile(out)
+
+	_, err = io.Copy(out, r)
+
+	return err
+}
+
+func (d *Downloader) httpClient() *http.Client {
+	if d.HTTPClient != nil {
+		return d.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (d *Downloader) maxRetries() int {
+	if d.MaxRetries > 0 {
+		return d.MaxRetries
+	}
+	return defaultDownloadMaxRetries
+}
+
+func (d *Downloader) backoff(attempt int) time.Duration {
+	minBackoff, maxBackoff := d.MinBackoff, d.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultDownloadMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultDownloadMaxBackoff
+	}
+
+	backoff := minBackoff << attempt
+	if backoff <= 0 || backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	// Equal jitter
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// resumableReader reads the raw content of the URL, reopening it from the read offset on failures.
+type resumableReader struct {
+	ctx context.Context
+	d   *Downloader
+	url string
+
+	body        io.ReadCloser
+	offset      int64
+	size        int64
+	gzipEncoded bool
+	retries     int
+
+	hash        hash.Hash
+	expectedMD5 []byte
+}
+
+func (r *resumableReader) Read(p []byte) (int, error) {
+	for {
+		if r.body == nil {
+			if err := r.open(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := r.body.Read(p)
+		if n > 0 {
+			r.offset += int64(n)
+			r.retries = 0
+			if r.hash != nil {
+				r.hash.Write(p[:n])
+			}
+		}
+
+		if err == nil {
+			return n, nil
+		}
+		if errors.Is(err, io.EOF) && (r.size < 0 || r.offset >= r.size) {
+			if err := r.verify(); err != nil {
+				return n, err
+			}
+			return n, io.EOF
+		}
+
+		// The connection is broken or closed before the end of the content.
+		r.body.Close()
+		r.body = nil
+		if n > 0 {
+			return n, nil
+		}
+		if err := r.wait(err); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (r *resumableReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+	err := r.body.Close()
+	r.body = nil
+	return err
+}
+
+// open requests the content from the read offset, retrying the transient failures.
+func (r *resumableReader) open() error {
+	for {
+		retryable, err := r.request()
+		if err == nil {
+			return nil
+		}
+		if !retryable {
+			return err
+		}
+		if err := r.wait(err); err != nil {
+			return err
+		}
+	}
+}
+
+// wait sleeps before the next attempt, or returns the error if no attempts are left.
+func (r *resumableReader) wait(err error) error {
+	if r.ctx.Err() != nil {
+		return r.ctx.Err()
+	}
+	if r.retries >= r.d.maxRetries() {
+		return fmt.Errorf("download: giving up after %d retries: %w", r.retries, err)
+	}
+
+	t := time.NewTimer(r.d.backoff(r.retries))
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-r.ctx.Done():
+		return r.ctx.Err()
+	}
+	r.retries++
+
+	return nil
+}
+
+func (r *resumableReader) request() (retryable bool, err error) {
+	req, err := http.NewRequestWithContext(r.ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return false, err
+	}
+	// Asking for gzip explicitly keeps the transport from decompressing the content, so that the offsets are those of the raw content.
+	req.Header.Set("Accept-Encoding", "gzip")
+	if r.offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", r.offset))
+	}
+
+	resp, err := r.d.httpClient().Do(req)
+	if err != nil {
+		return r.ctx.Err() == nil, fmt.Errorf("download: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		if r.offset == 0 {
+			if err := r.start(resp); err != nil {
+				resp.Body.Close()
+				return false, err
+			}
+		} else if _, err := io.CopyN(io.Discard, resp.Body, r.offset); err != nil {
+			// The server ignored the range, skip the content read so far
+			resp.Body.Close()
+			return true, fmt.Errorf("download: %w", err)
+		}
+	case resp.StatusCode == http.StatusPartialContent && r.offset > 0:
+		if start, ok := contentRangeStart(resp.Header.Get("Content-Range")); !ok || start != r.offset {
+			resp.Body.Close()
+			return false, fmt.Errorf("%w: got range %q for offset %d", ErrDownloadNotResumable, resp.Header.Get("Content-Range"), r.offset)
+		}
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && r.offset > 0 && r.offset == r.size:
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(nil))
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		resp.Body.Close()
+		err := fmt.Errorf("download: unexpected status %s: %s", resp.Status, body)
+		return isRetryableStatus(resp.StatusCode), err
+	}
+
+	r.body = resp.Body
+
+	return false, nil
+}
+
+// start checks the response of the first request and records the size, encoding and checksum of the content.
+func (r *resumableReader) start(resp *http.Response) error {
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil && mediaType == "text/html" {
+		return fmt.Errorf("download: %w %s", ErrUnexpectedContentType, mediaType)
+	}
+
+	r.size = resp.ContentLength
+	r.gzipEncoded = strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip")
+	if sum := contentMD5(resp.Header); sum != nil {
+		r.hash = md5.New()
+		r.expectedMD5 = sum
+	}
+
+	return nil
+}
+
+func (r *resumableReader) verify() error {
+	if r.hash == nil {
+		return nil
+	}
+	if !bytes.Equal(r.hash.Sum(nil), r.expectedMD5) {
+		return ErrDownloadCorrupted
+	}
+	return nil
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// contentRangeStart returns the first byte position of the `bytes <start>-<end>/<size>` content range.
+func contentRangeStart(contentRange string) (int64, bool) {
+	spec, ok := strings.CutPrefix(contentRange, "bytes ")
+	if !ok {
+		return 0, false
+	}
+	start, _, ok := strings.Cut(spec, "-")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(start, 10, 64)
+	return n, err == nil
+}
+
+// contentMD5 returns the MD5 checksum in the `Content-MD5` or the Google Cloud Storage `x-goog-hash` headers.
+func contentMD5(h http.Header) []byte {
+	values := append([]string{}, h.Values("Content-MD5")...)
+	for _, v := range h.Values("X-Goog-Hash") {
+		for _, part := range strings.Split(v, ",") {
+			if sum, ok := strings.CutPrefix(strings.TrimSpace(part), "md5="); ok {
+				values = append(values, sum)
+			}
+		}
+	}
+
+	for _, v := range values {
+		if sum, err := base64.StdEncoding.DecodeString(v); err == nil && len(sum) == md5.Size {
+			return sum
+		}
+	}
+	return nil
+}
+
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n <= 0 {
+		// The content may end exactly at the limit
+		var b [1]byte
+		n, err := l.r.Read(b[:])
+		if n > 0 {
+			return 0, ErrDownloadTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(p)) > l.n {
+		p = p[:l.n]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	return n, err
+}
+
+type downloadReader struct {
+	io.Reader
+	raw *resumableReader
+	gz  *gzip.Reader
+}
+
+func (r *downloadReader) Close() error {
+	if r.gz != nil {
+		r.gz.Close()
+	}
+	return r.raw.Close()
+}
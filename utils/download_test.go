@@ -0,0 +1,181 @@
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testContent = strings.Repeat(`{"id":"gid://shopify/Product/1"}`+"\n", 1000)
+
+// flakyServer serves the content honouring the range requests, but breaks the first connection in the middle.
+func flakyServer(t *testing.T, content []byte, header http.Header) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+
+		start := 0
+		if rng := r.Header.Get("Range"); rng != "" {
+			var err error
+			start, err = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rng, "bytes="), "-"))
+			if err != nil {
+				t.Errorf("bad range %q", rng)
+			}
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(content)-1, len(content)))
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)-start))
+			w.WriteHeader(http.StatusPartialContent)
+		} else {
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		}
+
+		if n == 1 {
+			w.Write(content[:len(content)/2])
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.Write(content[start:])
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &requests
+}
+
+func testDownloader() *Downloader {
+	return &Downloader{MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+}
+
+func TestDownloaderResumes(t *testing.T) {
+	sum := md5.Sum([]byte(testContent))
+	srv, requests := flakyServer(t, []byte(testContent), http.Header{
+		"X-Goog-Hash": {"crc32c=AAAAAA==,md5=" + base64.StdEncoding.EncodeToString(sum[:])},
+	})
+
+	r, err := testDownloader().Open(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != testContent {
+		t.Errorf("got %d bytes, want %d", len(got), len(testContent))
+	}
+	if requests.Load() != 2 {
+		t.Errorf("got %d requests, want 2", requests.Load())
+	}
+}
+
+func TestDownloaderChecksumMismatch(t *testing.T) {
+	srv, _ := flakyServer(t, []byte(testContent), http.Header{
+		"Content-Md5": {base64.StdEncoding.EncodeToString(make([]byte, md5.Size))},
+	})
+
+	r, err := testDownloader().Open(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	_, err = io.ReadAll(r)
+	if !errors.Is(err, ErrDownloadCorrupted) {
+		t.Errorf("got %v, want %v", err, ErrDownloadCorrupted)
+	}
+}
+
+func TestDownloaderGzip(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write([]byte(testContent))
+	gz.Close()
+
+	srv, _ := flakyServer(t, buf.Bytes(), http.Header{"Content-Encoding": {"gzip"}})
+
+	r, err := testDownloader().Open(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != testContent {
+		t.Errorf("got %d bytes, want %d", len(got), len(testContent))
+	}
+}
+
+func TestDownloaderMaxSize(t *testing.T) {
+	srv, _ := flakyServer(t, []byte(testContent), nil)
+
+	d := testDownloader()
+	d.MaxSize = 100
+	_, err := d.Open(context.Background(), srv.URL)
+	if !errors.Is(err, ErrDownloadTooLarge) {
+		t.Errorf("got %v, want %v", err, ErrDownloadTooLarge)
+	}
+}
+
+func TestDownloaderStatus(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("<html>Access denied</html>"))
+	}))
+	defer srv.Close()
+
+	_, err := testDownloader().Open(context.Background(), srv.URL)
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Errorf("got %v, want the unexpected status error", err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("got %d requests, want 1", requests.Load())
+	}
+}
+
+func TestDownloaderRetriesServerErrors(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(testContent))
+	}))
+	defer srv.Close()
+
+	r, err := testDownloader().Open(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != testContent {
+		t.Errorf("got %d bytes, want %d", len(got), len(testContent))
+	}
+}
@@ -1,8 +1,7 @@
 package utils
 
 import (
-	"io"
-	"net/http"
+	"context"
 	"os"
 )
 
@@ -20,20 +19,8 @@ func ReadFile(file string) (string, error) {
 	return data, err
 }
 
+// DownloadFile downloads the file into the file path with the default Downloader.
 func DownloadFile(filepath string, url string) error {
-	resp, err := http.Get(url)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	out, err := os.Create(filepath)
-	if err != nil {
-		return err
-	}
-	defer CloseFile(out)
-
-	_, err = io.Copy(out, resp.Body)
-
-	return err
+	d := &Downloader{}
+	return d.DownloadFile(context.Background(), filepath, url)
 }